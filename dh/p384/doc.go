@@ -0,0 +1,20 @@
+/*
+Package p384 provides Elliptic Curve Diffie-Hellman (ECDH) over the P-384
+curve, as specified in SEC 1, Section 3.3.1 [1].
+
+Validation of public keys.
+
+A peer's public key that is not a point of the curve enables invalid-curve
+attacks, which can leak the private key [2,3]. Thus, the ECDH function
+checks that the public key is a canonical SEC 1 encoding, in uncompressed or
+compressed form, of a point on the curve that is not the point at infinity;
+otherwise it returns an error. Since P-384 has prime order, no further
+validation is required.
+
+References:
+ - [1] SEC 1 v2.0 (https://www.secg.org/sec1-v2.pdf)
+ - [2] Biehl, Meyer, Müller (https://doi.org/10.1007/3-540-44598-6_8)
+ - [3] Jager, Schwenk, Somorovsky (https://doi.org/10.1007/978-3-319-24174-6_21)
+
+*/
+package p384
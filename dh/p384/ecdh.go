@@ -0,0 +1,110 @@
+package p384
+
+import (
+	"errors"
+	"io"
+
+	ecc "github.com/cloudflare/circl/ecc/p384"
+)
+
+const (
+	// PrivateKeySize is the length in bytes of a private key.
+	PrivateKeySize = ecc.ScalarSize
+	// PublicKeySize is the length in bytes of a public key, which is encoded
+	// in SEC 1 uncompressed form.
+	PublicKeySize = ecc.UncompressedSize
+	// SharedSize is the length in bytes of a shared secret.
+	SharedSize = 48
+)
+
+var (
+	errInvalidPrivateKey = errors.New("p384: invalid private key")
+	errInvalidPublicKey  = errors.New("p384: invalid public key")
+	errIdentity          = errors.New("p384: shared secret is the point at infinity")
+)
+
+// PrivateKey is an ECDH private key, together with its public key.
+type PrivateKey struct {
+	d   ecc.Scalar
+	pub [PublicKeySize]byte
+}
+
+// GenerateKey returns a new private key using rand as source of randomness.
+func GenerateKey(rand io.Reader) (*PrivateKey, error) {
+	var b [PrivateKeySize]byte
+	for {
+		if _, err := io.ReadFull(rand, b[:]); err != nil {
+			return nil, err
+		}
+		if k, err := NewPrivateKey(b[:]); err == nil {
+			return k, nil
+		}
+	}
+}
+
+// NewPrivateKey returns the private key encoded in b, which must be a
+// big-endian integer of PrivateKeySize bytes in the range [1, N-1], where N
+// is the order of the curve.
+func NewPrivateKey(b []byte) (*PrivateKey, error) {
+	var in [PrivateKeySize]byte
+	var k PrivateKey
+	if len(b) != PrivateKeySize {
+		return nil, errInvalidPrivateKey
+	}
+	copy(in[:], b)
+	if !k.d.Unmarshal(&in) || k.d.IsZero() {
+		return nil, errInvalidPrivateKey
+	}
+	var P ecc.Point
+	P.ScalarBaseMult(&in)
+	P.Marshal(&k.pub)
+	return &k, nil
+}
+
+// Bytes returns the encoding of the private key.
+func (k *PrivateKey) Bytes() []byte {
+	var out [PrivateKeySize]byte
+	k.d.Marshal(&out)
+	return out[:]
+}
+
+// PublicKey returns the public key in SEC 1 uncompressed form.
+func (k *PrivateKey) PublicKey() []byte {
+	out := k.pub
+	return out[:]
+}
+
+// ECDH returns the shared secret, that is, the x-coordinate of the product
+// of the private key and the peer's public key. The public key must be in SEC
+// 1 uncompressed or compressed form; an error is returned if it is not a
+// valid encoding of a point of the curve different from the point at
+// infinity.
+func ECDH(priv *PrivateKey, peerPub []byte) ([]byte, error) {
+	var P ecc.Point
+	switch len(peerPub) {
+	case ecc.UncompressedSize:
+		var in [ecc.UncompressedSize]byte
+		copy(in[:], peerPub)
+		if !P.Unmarshal(&in) {
+			return nil, errInvalidPublicKey
+		}
+	case ecc.CompressedSize:
+		var in [ecc.CompressedSize]byte
+		copy(in[:], peerPub)
+		if !P.UnmarshalCompressed(&in) {
+			return nil, errInvalidPublicKey
+		}
+	default:
+		return nil, errInvalidPublicKey
+	}
+
+	var k [PrivateKeySize]byte
+	var out [ecc.UncompressedSize]byte
+	priv.d.Marshal(&k)
+	P.ScalarMult(&k, &P)
+	if P.IsIdentity() {
+		return nil, errIdentity
+	}
+	P.Marshal(&out)
+	return out[1 : 1+SharedSize], nil
+}
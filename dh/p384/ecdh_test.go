@@ -0,0 +1,177 @@
+package p384_test
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/circl/dh/p384"
+	"github.com/cloudflare/circl/internal/test"
+)
+
+func TestECDH(t *testing.T) {
+	const testTimes = 1 << 6
+	StdCurve := elliptic.P384()
+
+	t.Run("shared", func(t *testing.T) {
+		for i := 0; i < testTimes; i++ {
+			alice, err := p384.GenerateKey(rand.Reader)
+			test.CheckNoErr(t, err, "keygen failed")
+			bob, err := p384.GenerateKey(rand.Reader)
+			test.CheckNoErr(t, err, "keygen failed")
+
+			sA, err := p384.ECDH(alice, bob.PublicKey())
+			test.CheckNoErr(t, err, "ECDH failed")
+			sB, err := p384.ECDH(bob, alice.PublicKey())
+			test.CheckNoErr(t, err, "ECDH failed")
+			if !bytes.Equal(sA, sB) || len(sA) != p384.SharedSize {
+				test.ReportError(t, sA, sB, i)
+			}
+
+			// Agrees with crypto/elliptic.
+			x, y := elliptic.Unmarshal(StdCurve, bob.PublicKey())
+			x, _ = StdCurve.ScalarMult(x, y, alice.Bytes())
+			want := make([]byte, p384.SharedSize)
+			xb := x.Bytes()
+			copy(want[p384.SharedSize-len(xb):], xb)
+			if !bytes.Equal(sA, want) {
+				test.ReportError(t, sA, want, i)
+			}
+		}
+	})
+
+	t.Run("invalidPrivateKey", func(t *testing.T) {
+		N := StdCurve.Params().N
+		for _, k := range [][]byte{
+			make([]byte, p384.PrivateKeySize),
+			N.Bytes(),
+			bytes.Repeat([]byte{0xff}, p384.PrivateKeySize),
+			make([]byte, p384.PrivateKeySize-1),
+		} {
+			_, err := p384.NewPrivateKey(k)
+			if err == nil {
+				test.ReportError(t, err, "error", k)
+			}
+		}
+	})
+
+	t.Run("invalidPublicKey", func(t *testing.T) {
+		priv, _ := p384.GenerateKey(rand.Reader)
+		pub := priv.PublicKey()
+		params := StdCurve.Params()
+
+		// Point at infinity, in SEC 1 and as returned by elliptic.Marshal.
+		inf := make([]byte, p384.PublicKeySize)
+		inf[0] = 0x04
+		// (0, sqrt(b)) is a point of the curve; here, 0 is encoded as p.
+		sqrtB, _ := new(big.Int).SetString("c306610fb0ae5a159cf45c06069f22a6c5eb3641c602d42dea2c4b4f75550793406d80d2b91ad54f9048bd487af1ade1", 16)
+		canonical := append(make([]byte, 49), sqrtB.Bytes()...)
+		canonical[0] = 0x04
+		if _, err := p384.ECDH(priv, canonical); err != nil {
+			test.ReportError(t, err, nil, canonical)
+		}
+		unreduced := append([]byte{0x04}, params.P.Bytes()...)
+		unreduced = append(unreduced, sqrtB.Bytes()...)
+		// Point not on the curve.
+		offCurve := append([]byte{}, pub...)
+		offCurve[len(offCurve)-1] ^= 1
+
+		for _, pk := range [][]byte{nil, {0x00}, inf, unreduced, offCurve, pub[:50]} {
+			s, err := p384.ECDH(priv, pk)
+			if err == nil || s != nil {
+				test.ReportError(t, err, "error", pk)
+			}
+		}
+	})
+}
+
+type wycheproofECDH struct {
+	Groups []struct {
+		Curve    string `json:"curve"`
+		Encoding string `json:"encoding"`
+		Tests    []struct {
+			TcID    int      `json:"tcId"`
+			Comment string   `json:"comment"`
+			Public  string   `json:"public"`
+			Private string   `json:"private"`
+			Shared  string   `json:"shared"`
+			Result  string   `json:"result"`
+			Flags   []string `json:"flags"`
+		} `json:"tests"`
+	} `json:"testGroups"`
+}
+
+func (kat *wycheproofECDH) readFile(t *testing.T, fileName string) {
+	jsonFile, err := os.Open(fileName)
+	if err != nil {
+		t.Fatalf("File %v can not be opened. Error: %v", fileName, err)
+	}
+	defer jsonFile.Close()
+	input, _ := ioutil.ReadAll(jsonFile)
+
+	err = json.Unmarshal(input, &kat)
+	if err != nil {
+		t.Fatalf("File %v can not be loaded. Error: %v", fileName, err)
+	}
+}
+
+func TestWycheproof(t *testing.T) {
+	// Test vectors from Wycheproof (C2SP), testvectors_v1.
+	var kat wycheproofECDH
+	kat.readFile(t, "testdata/ecdh_secp384r1_ecpoint_test.json")
+
+	for _, g := range kat.Groups {
+		if g.Curve != "secp384r1" || g.Encoding != "ecpoint" {
+			t.Fatalf("unexpected group %v %v", g.Curve, g.Encoding)
+		}
+		for _, v := range g.Tests {
+			pub, err1 := hex.DecodeString(v.Public)
+			d, err2 := hex.DecodeString(v.Private)
+			want, err3 := hex.DecodeString(v.Shared)
+			if err1 != nil || err2 != nil || err3 != nil {
+				t.Fatalf("bad test vector %v", v.TcID)
+			}
+			k := make([]byte, p384.PrivateKeySize)
+			db := new(big.Int).SetBytes(d).Bytes()
+			copy(k[p384.PrivateKeySize-len(db):], db)
+
+			priv, err := p384.NewPrivateKey(k)
+			test.CheckNoErr(t, err, "bad private key")
+			got, err := p384.ECDH(priv, pub)
+
+			switch v.Result {
+			case "valid", "acceptable":
+				if err != nil || !bytes.Equal(got, want) {
+					test.ReportError(t, got, want, v.TcID, v.Comment, err)
+				}
+			case "invalid":
+				if err == nil {
+					test.ReportError(t, err, "error", v.TcID, v.Comment)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkECDH(b *testing.B) {
+	priv, _ := p384.GenerateKey(rand.Reader)
+	peer, _ := p384.GenerateKey(rand.Reader)
+	pub := peer.PublicKey()
+
+	b.Run("GenerateKey", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = p384.GenerateKey(rand.Reader)
+		}
+	})
+	b.Run("ECDH", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = p384.ECDH(priv, pub)
+		}
+	})
+}
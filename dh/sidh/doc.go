@@ -2,14 +2,16 @@
 // Supersingular Isogeny Diffie-Hellman (SIDH) as well as Supersingular
 // Isogeny Key Encapsulation (SIKE).
 //
-// It comes with implementations of 2 different field arithmetic
-// implementations sidh.Fp503 and sidh.Fp751.
+// It comes with implementations of 4 different field arithmetic
+// implementations sidh.Fp434, sidh.Fp503, sidh.Fp610 and sidh.Fp751.
 //
 //	| Algoirthm | Public Key Size | Shared Secret Size | Ciphertext Size |
 //	|-----------|-----------------|--------------------|-----------------|
 //	| SIDH/p503 |          376    |        126         | N/A             |
+//	| SIDH/p610 |          462    |        154         | N/A             |
 //	| SIDH/p751 |          564    |        188         | N/A             |
 //	| SIKE/p503 |          376    |         16         | 402             |
+//	| SIKE/p610 |          462    |         24         | 486             |
 //	| SIKE/p751 |          564    |         24         | 596             |
 //
 // In order to instantiate SIKE/p751 KEM one needs to create a KEM object
@@ -20,9 +22,31 @@
 //	kem.Encapsulate(ciphertext, sharedSecret, publicBob)
 //	kem.Decapsulate(sharedSecret, privateBob, PublicBob, ciphertext)
 //
+// KEM object keeps internal state, hence it must not be used by multiple
+// goroutines at the same time. Scheme provides the same functionality
+// without mutable state, allocates outputs on each call and reports
+// wrongly formated input with an error instead of panicking.
+//
+//	s, err := sidh.NewScheme(sidh.Fp751, rand.Reader)
+//	ciphertext, sharedSecret, err := s.Encapsulate(publicBob)
+//	sharedSecret, err = s.Decapsulate(privateBob, publicBob, ciphertext)
+//
+// For known answer tests and reproducible transcripts Scheme also provides
+// GenerateKeyPairFromSeed and EncapsulateDeterministically, which take
+// randomness as an explicit seed.
+//
+// PublicKey.Import doesn't validate the key. Untrusted public keys can be
+// checked with PublicKey.ImportWithOptions or PublicKey.Validate, which
+// reject non-canonical encodings, points not on a supersingular curve and
+// points that don't generate the full torsion subgroup.
+//
 // Code is optimized for AMD64 and aarch64. Generic implementation
 // is provided for other architectures.
 //
+// Public keys and ciphertexts use the uncompressed encoding. The compressed
+// SIKE variants from round 3 of the NIST process (SIKEp434_compressed and
+// others) are not implemented.
+//
 // References:
 // - [SIDH] https://eprint.iacr.org/2011/506
 // - [SIKE] http://www.sike.org/files/SIDH-spec.pdf
@@ -23,6 +23,7 @@ const (
 	Fp503 uint8 = iota
 	Fp751
 	Fp434
+	Fp610
 )
 
 // Representation of an element of the base field F_p.
@@ -23,6 +23,20 @@ func traverseTreePublicKeyA(curve *ProjectiveCurveParameters, xR, phiP, phiQ, ph
 	strat := params.A.IsogenyStrategy
 	stratSz := len(strat)
 
+	// If e2 is odd, start with a 2-isogeny, so that the rest of
+	// the tree can be traversed with 4-isogenies.
+	if params.A.SecretBitLen%2 == 1 {
+		var phi2 isogeny2
+		var xS = *xR
+
+		Pow2k(&xS, &cparam, uint32(params.A.SecretBitLen-1))
+		cparam = phi2.GenerateCurve(&xS)
+		*xR = phi2.EvaluatePoint(xR)
+		*phiP = phi2.EvaluatePoint(phiP)
+		*phiQ = phi2.EvaluatePoint(phiQ)
+		*phiR = phi2.EvaluatePoint(phiR)
+	}
+
 	for j := 1; j <= stratSz; j++ {
 		for i <= stratSz-j {
 			points = append(points, *xR)
@@ -60,6 +74,17 @@ func traverseTreeSharedKeyA(curve *ProjectiveCurveParameters, xR *ProjectivePoin
 	strat := params.A.IsogenyStrategy
 	stratSz := len(strat)
 
+	// If e2 is odd, start with a 2-isogeny, so that the rest of
+	// the tree can be traversed with 4-isogenies.
+	if params.A.SecretBitLen%2 == 1 {
+		var phi2 isogeny2
+		var xS = *xR
+
+		Pow2k(&xS, &cparam, uint32(params.A.SecretBitLen-1))
+		cparam = phi2.GenerateCurve(&xS)
+		*xR = phi2.EvaluatePoint(xR)
+	}
+
 	for j := 1; j <= stratSz; j++ {
 		for i <= stratSz-j {
 			points = append(points, *xR)
@@ -221,6 +246,135 @@ func PublicKeyGenB(pub3Pt *[3]Fp2, prvBytes []byte) {
 	mul(&pub3Pt[2], &xRB.X, &invZR)
 }
 
+// -----------------------------------------------------------------------------
+// Public key validation
+//
+
+// Returns true if x(P) = x(Q). Points are in projective coordinates.
+func equalX(P, Q *ProjectivePoint) bool {
+	var t0, t1 Fp2
+	mul(&t0, &P.X, &Q.Z)
+	mul(&t1, &Q.X, &P.Z)
+	sub(&t0, &t0, &t1)
+	return isZero(&t0)
+}
+
+// Returns true if there is a point with x-coordinate x on the curve
+// y^2 = x^3 + Ax^2 + x defined over Fp2.
+func isOnCurve(a, x *Fp2) bool {
+	var t Fp2
+	add(&t, x, a)               // t = x + A
+	mul(&t, &t, x)              // t = x^2 + Ax
+	add(&t, &t, &params.OneFp2) // t = x^2 + Ax + 1
+	mul(&t, &t, x)              // t = x^3 + Ax^2 + x
+	return isSquare(&t)
+}
+
+// ValidateCurve recovers coefficient A of the curve E_A: y^2 = x^3 + Ax^2 + x
+// from the public key (x(P), x(Q), x(P-Q)) and checks that E_A is nonsingular,
+// that all three x-coordinates correspond to points on E_A(Fp2) and that E_A
+// is supersingular. Recovered coefficients are stored in curve. Returns false
+// if any of the checks fails.
+//
+// Supersingularity is verified by checking that [p+1]T = O, where T is a point
+// on E_A derived from the public key. Any supersingular curve in the isogeny
+// class of E_0 has (p+1)^2 points over Fp2, while for an ordinary curve the
+// check succeeds only with negligible probability.
+//
+// Not constant time, must be used only on public data.
+func ValidateCurve(curve *ProjectiveCurveParameters, pub3Pt *[3]Fp2) bool {
+	var t0, t1 Fp2
+	var xT ProjectivePoint
+
+	// RecoverCoordinateA divides by 4*xP*xQ*x(P-Q)
+	mul(&t0, &pub3Pt[0], &pub3Pt[1])
+	mul(&t0, &t0, &pub3Pt[2])
+	if isZero(&t0) {
+		return false
+	}
+	curve.C = params.OneFp2
+	RecoverCoordinateA(curve, &pub3Pt[0], &pub3Pt[1], &pub3Pt[2])
+
+	// E_A is singular iff A = 2 or A = -2
+	add(&t0, &params.OneFp2, &params.OneFp2)
+	sub(&t1, &curve.A, &t0)
+	add(&t0, &curve.A, &t0)
+	if isZero(&t0) || isZero(&t1) {
+		return false
+	}
+
+	for i := range pub3Pt {
+		if !isOnCurve(&curve.A, &pub3Pt[i]) {
+			return false
+		}
+	}
+
+	// Find a point T on E_A, starting from x(T) = xP+xQ+x(P-Q). Each candidate
+	// is on E_A with probability around 1/2.
+	add(&xT.X, &pub3Pt[0], &pub3Pt[1])
+	add(&xT.X, &xT.X, &pub3Pt[2])
+	xT.Z = params.OneFp2
+	for i := 0; !isOnCurve(&curve.A, &xT.X); i++ {
+		if i == 128 {
+			return false
+		}
+		add(&xT.X, &xT.X, &params.OneFp2)
+	}
+
+	// p+1 = 2^e2 * 3^e3
+	cparam := CalcCurveParamsEquiv4(curve)
+	Pow2k(&xT, &cparam, uint32(params.A.SecretBitLen))
+	cparam = CalcCurveParamsEquiv3(curve)
+	Pow3k(&xT, &cparam, uint32(len(params.B.IsogenyStrategy)+1))
+	return isZero(&xT.Z)
+}
+
+// ValidateOrderA checks that the points P and Q from the public key generated
+// by PublicKeyGenA both have order 3^e3 on the curve recovered by ValidateCurve,
+// and that they are independent, i.e. they generate E_A[3^e3].
+//
+// Not constant time, must be used only on public data.
+func ValidateOrderA(curve *ProjectiveCurveParameters, pub3Pt *[3]Fp2) bool {
+	// Length of the strategy is e3-1
+	var e3 = uint32(len(params.B.IsogenyStrategy) + 1)
+	var xP = ProjectivePoint{X: pub3Pt[0], Z: params.OneFp2}
+	var xQ = ProjectivePoint{X: pub3Pt[1], Z: params.OneFp2}
+
+	cparam := CalcCurveParamsEquiv3(curve)
+	Pow3k(&xP, &cparam, e3-1)
+	Pow3k(&xQ, &cparam, e3-1)
+	// [3^(e3-1)]P and [3^(e3-1)]Q must be points of order 3, which
+	// don't generate the same subgroup.
+	if isZero(&xP.Z) || isZero(&xQ.Z) || equalX(&xP, &xQ) {
+		return false
+	}
+	Pow3k(&xP, &cparam, 1)
+	Pow3k(&xQ, &cparam, 1)
+	return isZero(&xP.Z) && isZero(&xQ.Z)
+}
+
+// ValidateOrderB checks that the points P and Q from the public key generated
+// by PublicKeyGenB both have order 2^e2 on the curve recovered by ValidateCurve,
+// and that they are independent, i.e. they generate E_A[2^e2].
+//
+// Not constant time, must be used only on public data.
+func ValidateOrderB(curve *ProjectiveCurveParameters, pub3Pt *[3]Fp2) bool {
+	var e2 = uint32(params.A.SecretBitLen)
+	var xP = ProjectivePoint{X: pub3Pt[0], Z: params.OneFp2}
+	var xQ = ProjectivePoint{X: pub3Pt[1], Z: params.OneFp2}
+
+	cparam := CalcCurveParamsEquiv4(curve)
+	Pow2k(&xP, &cparam, e2-1)
+	Pow2k(&xQ, &cparam, e2-1)
+	// [2^(e2-1)]P and [2^(e2-1)]Q must be distinct points of order 2
+	if isZero(&xP.Z) || isZero(&xQ.Z) || equalX(&xP, &xQ) {
+		return false
+	}
+	Pow2k(&xP, &cparam, 1)
+	Pow2k(&xQ, &cparam, 1)
+	return isZero(&xP.Z) && isZero(&xQ.Z)
+}
+
 // -----------------------------------------------------------------------------
 // Key agreement functions
 //
@@ -7,6 +7,12 @@ import (
 	. "github.com/cloudflare/circl/dh/sidh/internal/common"
 )
 
+// Stores isogeny 2 curve constants
+type isogeny2 struct {
+	K1 Fp2
+	K2 Fp2
+}
+
 // Stores isogeny 3 curve constants
 type isogeny3 struct {
 	K1 Fp2
@@ -252,6 +258,46 @@ func ScalarMul3Pt(cparams *ProjectiveCurveParameters, P, Q, PmQ *ProjectivePoint
 	return R1
 }
 
+// Given a two-torsion point p = x(PA) on the curve E_(A:C), construct the
+// two-isogeny phi : E_(A:C) -> E_(A:C)/<P_2> = E_(A':C').
+//
+// Input: (XP_2: ZP_2), where P_2 has exact order 2 on E_A/C and P_2 != (0,0)
+// Output: * Curve coordinates (A' + 2C', 4C') corresponding to E_A'/C' = A_E/C/<P2>
+//         * Isogeny phi with constants in F_p^2
+func (phi *isogeny2) GenerateCurve(p *ProjectivePoint) CurveCoefficientsEquiv {
+	var coefEq CurveCoefficientsEquiv
+	var K1, K2 = &phi.K1, &phi.K2
+
+	add(K1, &p.X, &p.Z)                  // K1 = XP2 + ZP2
+	sub(K2, &p.X, &p.Z)                  // K2 = XP2 - ZP2
+	sqr(&coefEq.A, &p.X)                 // A24p = XP2^2
+	sqr(&coefEq.C, &p.Z)                 // C24 = ZP2^2
+	sub(&coefEq.A, &coefEq.C, &coefEq.A) // A24p = C24 - A24p
+	return coefEq
+}
+
+// Given a 2-isogeny phi and a point pA = x(PA), compute x(QA), the x-coordinate
+// of the image QA = phi(PA) of PA under phi : E_(A:C) -> E_(A':C').
+//
+// The output xQ = x(Q) is then a point on the curve E_(A':C'); the curve
+// parameters are returned by the GenerateCurve function used to construct phi.
+func (phi *isogeny2) EvaluatePoint(p *ProjectivePoint) ProjectivePoint {
+	var t0, t1, t2 Fp2
+	var q ProjectivePoint
+	var K1, K2 = &phi.K1, &phi.K2
+	var px, pz = &p.X, &p.Z
+
+	add(&t0, px, pz)   // t0 = XQ + ZQ
+	sub(&t1, px, pz)   // t1 = XQ - ZQ
+	mul(&t0, K2, &t0)  // t0 = K2 * t0
+	mul(&t1, K1, &t1)  // t1 = K1 * t1
+	add(&t2, &t1, &t0) // t2 = t1 + t0
+	sub(&t0, &t1, &t0) // t0 = t1 - t0
+	mul(&q.X, px, &t2) // XQ'= XQ * t2
+	mul(&q.Z, pz, &t0) // ZQ'= ZQ * t0
+	return q
+}
+
 // Given a three-torsion point p = x(PB) on the curve E_(A:C), construct the
 // three-isogeny phi : E_(A:C) -> E_(A:C)/<P_3> = E_(A':C').
 //
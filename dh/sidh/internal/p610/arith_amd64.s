@@ -0,0 +1,2542 @@
+// +build amd64,!noasm
+
+#include "textflag.h"
+
+// Redefine P610p1Zeros
+#define P610_P1_ZEROS 4
+
+TEXT ·cswapP610(SB),NOSPLIT,$0-17
+
+    MOVQ    x+0(FP), DI
+    MOVQ    y+8(FP), SI
+    MOVB    choice+16(FP), AL   // AL = 0 or 1
+    MOVBLZX AL, AX  // AX = 0 or 1
+    NEGQ    AX          // AX = 0x00..00 or 0xff..ff
+#ifndef CSWAP_BLOCK
+#define CSWAP_BLOCK(idx)    \
+    MOVQ    (idx*8)(DI), BX \ // BX = x[idx]
+    MOVQ    (idx*8)(SI), CX \ // CX = y[idx]
+    MOVQ    CX, DX          \ // DX = y[idx]
+    XORQ    BX, DX          \ // DX = y[idx] ^ x[idx]
+    ANDQ    AX, DX          \ // DX = (y[idx] ^ x[idx]) & mask
+    XORQ    DX, BX          \ // BX = (y[idx] ^ x[idx]) & mask) ^ x[idx] = x[idx] or y[idx]
+    XORQ    DX, CX          \ // CX = (y[idx] ^ x[idx]) & mask) ^ y[idx] = y[idx] or x[idx]
+    MOVQ    BX, (idx*8)(DI) \
+    MOVQ    CX, (idx*8)(SI)
+#endif
+    CSWAP_BLOCK(0)
+    CSWAP_BLOCK(1)
+    CSWAP_BLOCK(2)
+    CSWAP_BLOCK(3)
+    CSWAP_BLOCK(4)
+    CSWAP_BLOCK(5)
+    CSWAP_BLOCK(6)
+    CSWAP_BLOCK(7)
+    CSWAP_BLOCK(8)
+    CSWAP_BLOCK(9)
+#ifdef CSWAP_BLOCK
+#undef CSWAP_BLOCK
+#endif
+    RET
+
+TEXT ·addP610(SB),NOSPLIT,$0-24
+    MOVQ    z+0(FP), DX
+    MOVQ    x+8(FP), DI
+    MOVQ    y+16(FP), SI
+
+    // Used later to calculate a mask
+    XORQ    CX, CX
+
+    // [R8-R15,AX,BX]: z = x + y
+    MOVQ    ( 0)(DI), R8; ADDQ    ( 0)(SI), R8
+    MOVQ    ( 8)(DI), R9; ADCQ    ( 8)(SI), R9
+    MOVQ    (16)(DI), R10; ADCQ    (16)(SI), R10
+    MOVQ    (24)(DI), R11; ADCQ    (24)(SI), R11
+    MOVQ    (32)(DI), R12; ADCQ    (32)(SI), R12
+    MOVQ    (40)(DI), R13; ADCQ    (40)(SI), R13
+    MOVQ    (48)(DI), R14; ADCQ    (48)(SI), R14
+    MOVQ    (56)(DI), R15; ADCQ    (56)(SI), R15
+    MOVQ    (64)(DI), AX; ADCQ    (64)(SI), AX
+    MOVQ    (72)(DI), BX; ADCQ    (72)(SI), BX
+
+    // z = z - P610x2
+    SUBQ    ·P610x2+0(SB), R8
+    SBBQ    ·P610x2+8(SB), R9
+    SBBQ    ·P610x2+16(SB), R10
+    SBBQ    ·P610x2+24(SB), R11
+    SBBQ    ·P610x2+32(SB), R12
+    SBBQ    ·P610x2+40(SB), R13
+    SBBQ    ·P610x2+48(SB), R14
+    SBBQ    ·P610x2+56(SB), R15
+    SBBQ    ·P610x2+64(SB), AX
+    SBBQ    ·P610x2+72(SB), BX
+
+    // mask
+    SBBQ    $0, CX
+
+    MOVQ    R8, ( 0)(DX)
+    MOVQ    R9, ( 8)(DX)
+    MOVQ    R10, (16)(DX)
+    MOVQ    R11, (24)(DX)
+    MOVQ    R12, (32)(DX)
+    MOVQ    R13, (40)(DX)
+    MOVQ    R14, (48)(DX)
+    MOVQ    R15, (56)(DX)
+    MOVQ    AX, (64)(DX)
+    MOVQ    BX, (72)(DX)
+
+    // if z<0 add P610x2 back
+    MOVQ    ·P610x2+0(SB), R8; ANDQ    CX, R8
+    MOVQ    ·P610x2+8(SB), R9; ANDQ    CX, R9
+    MOVQ    ·P610x2+16(SB), R10; ANDQ    CX, R10
+    MOVQ    ·P610x2+24(SB), R11; ANDQ    CX, R11
+    MOVQ    ·P610x2+32(SB), R12; ANDQ    CX, R12
+    MOVQ    ·P610x2+40(SB), R13; ANDQ    CX, R13
+    MOVQ    ·P610x2+48(SB), R14; ANDQ    CX, R14
+    MOVQ    ·P610x2+56(SB), R15; ANDQ    CX, R15
+    MOVQ    ·P610x2+64(SB), AX; ANDQ    CX, AX
+    MOVQ    ·P610x2+72(SB), BX; ANDQ    CX, BX
+    ADDQ    R8, (0)(DX)
+    ADCQ    R9, (8)(DX)
+    ADCQ    R10, (16)(DX)
+    ADCQ    R11, (24)(DX)
+    ADCQ    R12, (32)(DX)
+    ADCQ    R13, (40)(DX)
+    ADCQ    R14, (48)(DX)
+    ADCQ    R15, (56)(DX)
+    ADCQ    AX, (64)(DX)
+    ADCQ    BX, (72)(DX)
+    RET
+
+TEXT ·adlP610(SB),NOSPLIT,$0-24
+    MOVQ    z+0(FP), DX
+    MOVQ    x+8(FP), DI
+    MOVQ    y+16(FP),SI
+
+    MOVQ    (0)(DI), R8
+    ADDQ    (0)(SI), R8
+    MOVQ    (8)(DI), R9
+    ADCQ    (8)(SI), R9
+    MOVQ    (16)(DI), R10
+    ADCQ    (16)(SI), R10
+    MOVQ    (24)(DI), R11
+    ADCQ    (24)(SI), R11
+    MOVQ    (32)(DI), R12
+    ADCQ    (32)(SI), R12
+    MOVQ    (40)(DI), R13
+    ADCQ    (40)(SI), R13
+    MOVQ    (48)(DI), R14
+    ADCQ    (48)(SI), R14
+    MOVQ    (56)(DI), R15
+    ADCQ    (56)(SI), R15
+    MOVQ    (64)(DI), AX
+    ADCQ    (64)(SI), AX
+    MOVQ    (72)(DI), BX
+    ADCQ    (72)(SI), BX
+
+    MOVQ    R8, (0)(DX)
+    MOVQ    R9, (8)(DX)
+    MOVQ    R10, (16)(DX)
+    MOVQ    R11, (24)(DX)
+    MOVQ    R12, (32)(DX)
+    MOVQ    R13, (40)(DX)
+    MOVQ    R14, (48)(DX)
+    MOVQ    R15, (56)(DX)
+    MOVQ    AX, (64)(DX)
+    MOVQ    BX, (72)(DX)
+
+    MOVQ    (80)(DI), R8
+    ADCQ    (80)(SI), R8
+    MOVQ    (88)(DI), R9
+    ADCQ    (88)(SI), R9
+    MOVQ    (96)(DI), R10
+    ADCQ    (96)(SI), R10
+    MOVQ    (104)(DI), R11
+    ADCQ    (104)(SI), R11
+    MOVQ    (112)(DI), R12
+    ADCQ    (112)(SI), R12
+    MOVQ    (120)(DI), R13
+    ADCQ    (120)(SI), R13
+    MOVQ    (128)(DI), R14
+    ADCQ    (128)(SI), R14
+    MOVQ    (136)(DI), R15
+    ADCQ    (136)(SI), R15
+    MOVQ    (144)(DI), AX
+    ADCQ    (144)(SI), AX
+    MOVQ    (152)(DI), BX
+    ADCQ    (152)(SI), BX
+
+    MOVQ    R8, (80)(DX)
+    MOVQ    R9, (88)(DX)
+    MOVQ    R10, (96)(DX)
+    MOVQ    R11, (104)(DX)
+    MOVQ    R12, (112)(DX)
+    MOVQ    R13, (120)(DX)
+    MOVQ    R14, (128)(DX)
+    MOVQ    R15, (136)(DX)
+    MOVQ    AX, (144)(DX)
+    MOVQ    BX, (152)(DX)
+    RET
+
+TEXT ·subP610(SB),NOSPLIT,$0-24
+    MOVQ    z+0(FP), DX
+    MOVQ    x+8(FP), DI
+    MOVQ    y+16(FP), SI
+
+    // Used later to calculate a mask
+    XORQ    CX, CX
+
+    MOVQ    ( 0)(DI), R8; SUBQ    ( 0)(SI), R8
+    MOVQ    ( 8)(DI), R9; SBBQ    ( 8)(SI), R9
+    MOVQ    (16)(DI), R10; SBBQ    (16)(SI), R10
+    MOVQ    (24)(DI), R11; SBBQ    (24)(SI), R11
+    MOVQ    (32)(DI), R12; SBBQ    (32)(SI), R12
+    MOVQ    (40)(DI), R13; SBBQ    (40)(SI), R13
+    MOVQ    (48)(DI), R14; SBBQ    (48)(SI), R14
+    MOVQ    (56)(DI), R15; SBBQ    (56)(SI), R15
+    MOVQ    (64)(DI), AX; SBBQ    (64)(SI), AX
+    MOVQ    (72)(DI), BX; SBBQ    (72)(SI), BX
+
+    // mask
+    SBBQ    $0, CX
+
+    MOVQ    R8, ( 0)(DX)
+    MOVQ    R9, ( 8)(DX)
+    MOVQ    R10, (16)(DX)
+    MOVQ    R11, (24)(DX)
+    MOVQ    R12, (32)(DX)
+    MOVQ    R13, (40)(DX)
+    MOVQ    R14, (48)(DX)
+    MOVQ    R15, (56)(DX)
+    MOVQ    AX, (64)(DX)
+    MOVQ    BX, (72)(DX)
+
+    // if z<0 add P610x2 back
+    MOVQ    ·P610x2+0(SB), R8; ANDQ    CX, R8
+    MOVQ    ·P610x2+8(SB), R9; ANDQ    CX, R9
+    MOVQ    ·P610x2+16(SB), R10; ANDQ    CX, R10
+    MOVQ    ·P610x2+24(SB), R11; ANDQ    CX, R11
+    MOVQ    ·P610x2+32(SB), R12; ANDQ    CX, R12
+    MOVQ    ·P610x2+40(SB), R13; ANDQ    CX, R13
+    MOVQ    ·P610x2+48(SB), R14; ANDQ    CX, R14
+    MOVQ    ·P610x2+56(SB), R15; ANDQ    CX, R15
+    MOVQ    ·P610x2+64(SB), AX; ANDQ    CX, AX
+    MOVQ    ·P610x2+72(SB), BX; ANDQ    CX, BX
+    ADDQ    R8, (0)(DX)
+    ADCQ    R9, (8)(DX)
+    ADCQ    R10, (16)(DX)
+    ADCQ    R11, (24)(DX)
+    ADCQ    R12, (32)(DX)
+    ADCQ    R13, (40)(DX)
+    ADCQ    R14, (48)(DX)
+    ADCQ    R15, (56)(DX)
+    ADCQ    AX, (64)(DX)
+    ADCQ    BX, (72)(DX)
+    RET
+
+TEXT ·sulP610(SB),NOSPLIT,$0-24
+    MOVQ    z+0(FP), DX
+    MOVQ    x+8(FP), DI
+    MOVQ    y+16(FP), SI
+
+    // Used later to store result of 0-borrow
+    XORQ    CX, CX
+
+    // SUBC for first 10 limbs
+    MOVQ    (0)(DI), R8; SUBQ    (0)(SI), R8
+    MOVQ    (8)(DI), R9; SBBQ    (8)(SI), R9
+    MOVQ    (16)(DI), R10; SBBQ    (16)(SI), R10
+    MOVQ    (24)(DI), R11; SBBQ    (24)(SI), R11
+    MOVQ    (32)(DI), R12; SBBQ    (32)(SI), R12
+    MOVQ    (40)(DI), R13; SBBQ    (40)(SI), R13
+    MOVQ    (48)(DI), R14; SBBQ    (48)(SI), R14
+    MOVQ    (56)(DI), R15; SBBQ    (56)(SI), R15
+    MOVQ    (64)(DI), AX; SBBQ    (64)(SI), AX
+    MOVQ    (72)(DI), BX; SBBQ    (72)(SI), BX
+
+    MOVQ    R8, (0)(DX)
+    MOVQ    R9, (8)(DX)
+    MOVQ    R10, (16)(DX)
+    MOVQ    R11, (24)(DX)
+    MOVQ    R12, (32)(DX)
+    MOVQ    R13, (40)(DX)
+    MOVQ    R14, (48)(DX)
+    MOVQ    R15, (56)(DX)
+    MOVQ    AX, (64)(DX)
+    MOVQ    BX, (72)(DX)
+
+    // SUBC for last 10 limbs
+    MOVQ    (80)(DI), R8; SBBQ    (80)(SI), R8
+    MOVQ    (88)(DI), R9; SBBQ    (88)(SI), R9
+    MOVQ    (96)(DI), R10; SBBQ    (96)(SI), R10
+    MOVQ    (104)(DI), R11; SBBQ    (104)(SI), R11
+    MOVQ    (112)(DI), R12; SBBQ    (112)(SI), R12
+    MOVQ    (120)(DI), R13; SBBQ    (120)(SI), R13
+    MOVQ    (128)(DI), R14; SBBQ    (128)(SI), R14
+    MOVQ    (136)(DI), R15; SBBQ    (136)(SI), R15
+    MOVQ    (144)(DI), AX; SBBQ    (144)(SI), AX
+    MOVQ    (152)(DI), BX; SBBQ    (152)(SI), BX
+
+    MOVQ    R8, (80)(DX)
+    MOVQ    R9, (88)(DX)
+    MOVQ    R10, (96)(DX)
+    MOVQ    R11, (104)(DX)
+    MOVQ    R12, (112)(DX)
+    MOVQ    R13, (120)(DX)
+    MOVQ    R14, (128)(DX)
+    MOVQ    R15, (136)(DX)
+    MOVQ    AX, (144)(DX)
+    MOVQ    BX, (152)(DX)
+
+    // Store carry flag
+    SBBQ    $0, CX
+
+    // if z<0 add P610 to the upper half
+    MOVQ    ·P610+0(SB), R8; ANDQ    CX, R8
+    MOVQ    ·P610+8(SB), R9; ANDQ    CX, R9
+    MOVQ    ·P610+16(SB), R10; ANDQ    CX, R10
+    MOVQ    ·P610+24(SB), R11; ANDQ    CX, R11
+    MOVQ    ·P610+32(SB), R12; ANDQ    CX, R12
+    MOVQ    ·P610+40(SB), R13; ANDQ    CX, R13
+    MOVQ    ·P610+48(SB), R14; ANDQ    CX, R14
+    MOVQ    ·P610+56(SB), R15; ANDQ    CX, R15
+    MOVQ    ·P610+64(SB), AX; ANDQ    CX, AX
+    MOVQ    ·P610+72(SB), BX; ANDQ    CX, BX
+    ADDQ    R8, (80)(DX)
+    ADCQ    R9, (88)(DX)
+    ADCQ    R10, (96)(DX)
+    ADCQ    R11, (104)(DX)
+    ADCQ    R12, (112)(DX)
+    ADCQ    R13, (120)(DX)
+    ADCQ    R14, (128)(DX)
+    ADCQ    R15, (136)(DX)
+    ADCQ    AX, (144)(DX)
+    ADCQ    BX, (152)(DX)
+    RET
+
+TEXT ·modP610(SB),NOSPLIT,$0-8
+    MOVQ    x+0(FP), DI
+
+    // Zero CX for later use:
+    XORQ    CX, CX
+
+    // Set x <- x - p
+    MOVQ    ·P610+0(SB), R8
+    MOVQ    ·P610+8(SB), R9
+    MOVQ    ·P610+16(SB), R10
+    MOVQ    ·P610+24(SB), R11
+    MOVQ    ·P610+32(SB), R12
+    MOVQ    ·P610+40(SB), R13
+    MOVQ    ·P610+48(SB), R14
+    MOVQ    ·P610+56(SB), R15
+    MOVQ    ·P610+64(SB), AX
+    MOVQ    ·P610+72(SB), BX
+    SUBQ    R8, ( 0)(DI)
+    SBBQ    R9, ( 8)(DI)
+    SBBQ    R10, (16)(DI)
+    SBBQ    R11, (24)(DI)
+    SBBQ    R12, (32)(DI)
+    SBBQ    R13, (40)(DI)
+    SBBQ    R14, (48)(DI)
+    SBBQ    R15, (56)(DI)
+    SBBQ    AX, (64)(DI)
+    SBBQ    BX, (72)(DI)
+
+    // save carry
+    SBBQ    $0, CX
+
+    // Conditionally add p to x if x-p < 0
+    ANDQ    CX, R8
+    ANDQ    CX, R9
+    ANDQ    CX, R10
+    ANDQ    CX, R11
+    ANDQ    CX, R12
+    ANDQ    CX, R13
+    ANDQ    CX, R14
+    ANDQ    CX, R15
+    ANDQ    CX, AX
+    ANDQ    CX, BX
+
+    ADDQ    R8, ( 0)(DI)
+    ADCQ    R9, ( 8)(DI)
+    ADCQ    R10, (16)(DI)
+    ADCQ    R11, (24)(DI)
+    ADCQ    R12, (32)(DI)
+    ADCQ    R13, (40)(DI)
+    ADCQ    R14, (48)(DI)
+    ADCQ    R15, (56)(DI)
+    ADCQ    AX, (64)(DI)
+    ADCQ    BX, (72)(DI)
+    RET
+
+// 640-bit multiplication using schoolbook method.
+TEXT ·mulP610(SB),NOSPLIT,$0-24
+    MOVQ    z+0(FP), CX
+    MOVQ    x+8(FP), DI
+    MOVQ    y+16(FP), SI
+
+    // Check wether to use optimized implementation
+    CMPB    ·HasADXandBMI2(SB), $1
+    JE      mul_with_mulx_adcx_adox
+
+    // Product scanning. Columns are accumulated in R8:R9:R10.
+    XORQ    R8, R8
+    XORQ    R9, R9
+    XORQ    R10, R10
+    MOVQ    (0)(DI), AX
+    MULQ    (0)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    R8, (0)(CX)
+    XORQ    R8, R8
+    MOVQ    (0)(DI), AX
+    MULQ    (8)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (8)(DI), AX
+    MULQ    (0)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    R9, (8)(CX)
+    XORQ    R9, R9
+    MOVQ    (0)(DI), AX
+    MULQ    (16)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (8)(DI), AX
+    MULQ    (8)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (16)(DI), AX
+    MULQ    (0)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    R10, (16)(CX)
+    XORQ    R10, R10
+    MOVQ    (0)(DI), AX
+    MULQ    (24)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (8)(DI), AX
+    MULQ    (16)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (16)(DI), AX
+    MULQ    (8)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (24)(DI), AX
+    MULQ    (0)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    R8, (24)(CX)
+    XORQ    R8, R8
+    MOVQ    (0)(DI), AX
+    MULQ    (32)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (8)(DI), AX
+    MULQ    (24)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (16)(DI), AX
+    MULQ    (16)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (24)(DI), AX
+    MULQ    (8)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (32)(DI), AX
+    MULQ    (0)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    R9, (32)(CX)
+    XORQ    R9, R9
+    MOVQ    (0)(DI), AX
+    MULQ    (40)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (8)(DI), AX
+    MULQ    (32)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (16)(DI), AX
+    MULQ    (24)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (24)(DI), AX
+    MULQ    (16)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (32)(DI), AX
+    MULQ    (8)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (40)(DI), AX
+    MULQ    (0)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    R10, (40)(CX)
+    XORQ    R10, R10
+    MOVQ    (0)(DI), AX
+    MULQ    (48)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (8)(DI), AX
+    MULQ    (40)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (16)(DI), AX
+    MULQ    (32)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (24)(DI), AX
+    MULQ    (24)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (32)(DI), AX
+    MULQ    (16)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (40)(DI), AX
+    MULQ    (8)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (48)(DI), AX
+    MULQ    (0)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    R8, (48)(CX)
+    XORQ    R8, R8
+    MOVQ    (0)(DI), AX
+    MULQ    (56)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (8)(DI), AX
+    MULQ    (48)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (16)(DI), AX
+    MULQ    (40)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (24)(DI), AX
+    MULQ    (32)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (32)(DI), AX
+    MULQ    (24)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (40)(DI), AX
+    MULQ    (16)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (48)(DI), AX
+    MULQ    (8)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (56)(DI), AX
+    MULQ    (0)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    R9, (56)(CX)
+    XORQ    R9, R9
+    MOVQ    (0)(DI), AX
+    MULQ    (64)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (8)(DI), AX
+    MULQ    (56)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (16)(DI), AX
+    MULQ    (48)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (24)(DI), AX
+    MULQ    (40)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (32)(DI), AX
+    MULQ    (32)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (40)(DI), AX
+    MULQ    (24)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (48)(DI), AX
+    MULQ    (16)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (56)(DI), AX
+    MULQ    (8)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (64)(DI), AX
+    MULQ    (0)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    R10, (64)(CX)
+    XORQ    R10, R10
+    MOVQ    (0)(DI), AX
+    MULQ    (72)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (8)(DI), AX
+    MULQ    (64)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (16)(DI), AX
+    MULQ    (56)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (24)(DI), AX
+    MULQ    (48)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (32)(DI), AX
+    MULQ    (40)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (40)(DI), AX
+    MULQ    (32)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (48)(DI), AX
+    MULQ    (24)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (56)(DI), AX
+    MULQ    (16)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (64)(DI), AX
+    MULQ    (8)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (72)(DI), AX
+    MULQ    (0)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    R8, (72)(CX)
+    XORQ    R8, R8
+    MOVQ    (8)(DI), AX
+    MULQ    (72)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (16)(DI), AX
+    MULQ    (64)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (24)(DI), AX
+    MULQ    (56)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (32)(DI), AX
+    MULQ    (48)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (40)(DI), AX
+    MULQ    (40)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (48)(DI), AX
+    MULQ    (32)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (56)(DI), AX
+    MULQ    (24)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (64)(DI), AX
+    MULQ    (16)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (72)(DI), AX
+    MULQ    (8)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    R9, (80)(CX)
+    XORQ    R9, R9
+    MOVQ    (16)(DI), AX
+    MULQ    (72)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (24)(DI), AX
+    MULQ    (64)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (32)(DI), AX
+    MULQ    (56)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (40)(DI), AX
+    MULQ    (48)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (48)(DI), AX
+    MULQ    (40)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (56)(DI), AX
+    MULQ    (32)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (64)(DI), AX
+    MULQ    (24)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (72)(DI), AX
+    MULQ    (16)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    R10, (88)(CX)
+    XORQ    R10, R10
+    MOVQ    (24)(DI), AX
+    MULQ    (72)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (32)(DI), AX
+    MULQ    (64)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (40)(DI), AX
+    MULQ    (56)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (48)(DI), AX
+    MULQ    (48)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (56)(DI), AX
+    MULQ    (40)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (64)(DI), AX
+    MULQ    (32)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (72)(DI), AX
+    MULQ    (24)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    R8, (96)(CX)
+    XORQ    R8, R8
+    MOVQ    (32)(DI), AX
+    MULQ    (72)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (40)(DI), AX
+    MULQ    (64)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (48)(DI), AX
+    MULQ    (56)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (56)(DI), AX
+    MULQ    (48)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (64)(DI), AX
+    MULQ    (40)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (72)(DI), AX
+    MULQ    (32)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    R9, (104)(CX)
+    XORQ    R9, R9
+    MOVQ    (40)(DI), AX
+    MULQ    (72)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (48)(DI), AX
+    MULQ    (64)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (56)(DI), AX
+    MULQ    (56)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (64)(DI), AX
+    MULQ    (48)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (72)(DI), AX
+    MULQ    (40)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    R10, (112)(CX)
+    XORQ    R10, R10
+    MOVQ    (48)(DI), AX
+    MULQ    (72)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (56)(DI), AX
+    MULQ    (64)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (64)(DI), AX
+    MULQ    (56)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    (72)(DI), AX
+    MULQ    (48)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    R8, (120)(CX)
+    XORQ    R8, R8
+    MOVQ    (56)(DI), AX
+    MULQ    (72)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (64)(DI), AX
+    MULQ    (64)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    (72)(DI), AX
+    MULQ    (56)(SI)
+    ADDQ    AX, R9
+    ADCQ    DX, R10
+    ADCQ    $0, R8
+    MOVQ    R9, (128)(CX)
+    XORQ    R9, R9
+    MOVQ    (64)(DI), AX
+    MULQ    (72)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    (72)(DI), AX
+    MULQ    (64)(SI)
+    ADDQ    AX, R10
+    ADCQ    DX, R8
+    ADCQ    $0, R9
+    MOVQ    R10, (136)(CX)
+    XORQ    R10, R10
+    MOVQ    (72)(DI), AX
+    MULQ    (72)(SI)
+    ADDQ    AX, R8
+    ADCQ    DX, R9
+    ADCQ    $0, R10
+    MOVQ    R8, (144)(CX)
+    MOVQ    R9, (152)(CX)
+    RET
+
+mul_with_mulx_adcx_adox:
+    // Operand scanning. For each row the products are accumulated in
+    // memory: CF chain adds low words, OF chain adds high words.
+    // z += x[0]*y*2^(64*0)
+    MOVQ    (0)(DI), DX
+    XORQ    AX, AX
+    MULXQ   (0)(SI), R8, R9
+    MOVQ    R8, (0)(CX)
+    MULXQ   (8)(SI), R8, R11
+    ADCXQ   R9, R8
+    MOVQ    R8, (8)(CX)
+    MULXQ   (16)(SI), R8, R9
+    ADCXQ   R11, R8
+    MOVQ    R8, (16)(CX)
+    MULXQ   (24)(SI), R8, R11
+    ADCXQ   R9, R8
+    MOVQ    R8, (24)(CX)
+    MULXQ   (32)(SI), R8, R9
+    ADCXQ   R11, R8
+    MOVQ    R8, (32)(CX)
+    MULXQ   (40)(SI), R8, R11
+    ADCXQ   R9, R8
+    MOVQ    R8, (40)(CX)
+    MULXQ   (48)(SI), R8, R9
+    ADCXQ   R11, R8
+    MOVQ    R8, (48)(CX)
+    MULXQ   (56)(SI), R8, R11
+    ADCXQ   R9, R8
+    MOVQ    R8, (56)(CX)
+    MULXQ   (64)(SI), R8, R9
+    ADCXQ   R11, R8
+    MOVQ    R8, (64)(CX)
+    MULXQ   (72)(SI), R8, R11
+    ADCXQ   R9, R8
+    MOVQ    R8, (72)(CX)
+    ADCXQ   AX, R11
+    MOVQ    R11, (80)(CX)
+    // z += x[1]*y*2^(64*1)
+    MOVQ    (8)(DI), DX
+    XORQ    AX, AX
+    MULXQ   (0)(SI), R8, R9
+    MOVQ    (8)(CX), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (8)(CX)
+    MULXQ   (8)(SI), R8, R11
+    MOVQ    (16)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (16)(CX)
+    MULXQ   (16)(SI), R8, R9
+    MOVQ    (24)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (24)(CX)
+    MULXQ   (24)(SI), R8, R11
+    MOVQ    (32)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (32)(CX)
+    MULXQ   (32)(SI), R8, R9
+    MOVQ    (40)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (40)(CX)
+    MULXQ   (40)(SI), R8, R11
+    MOVQ    (48)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (48)(CX)
+    MULXQ   (48)(SI), R8, R9
+    MOVQ    (56)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (56)(CX)
+    MULXQ   (56)(SI), R8, R11
+    MOVQ    (64)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (64)(CX)
+    MULXQ   (64)(SI), R8, R9
+    MOVQ    (72)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (72)(CX)
+    MULXQ   (72)(SI), R8, R11
+    MOVQ    (80)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (80)(CX)
+    ADCXQ   AX, R11
+    ADOXQ   AX, R11
+    MOVQ    R11, (88)(CX)
+    // z += x[2]*y*2^(64*2)
+    MOVQ    (16)(DI), DX
+    XORQ    AX, AX
+    MULXQ   (0)(SI), R8, R9
+    MOVQ    (16)(CX), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (16)(CX)
+    MULXQ   (8)(SI), R8, R11
+    MOVQ    (24)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (24)(CX)
+    MULXQ   (16)(SI), R8, R9
+    MOVQ    (32)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (32)(CX)
+    MULXQ   (24)(SI), R8, R11
+    MOVQ    (40)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (40)(CX)
+    MULXQ   (32)(SI), R8, R9
+    MOVQ    (48)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (48)(CX)
+    MULXQ   (40)(SI), R8, R11
+    MOVQ    (56)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (56)(CX)
+    MULXQ   (48)(SI), R8, R9
+    MOVQ    (64)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (64)(CX)
+    MULXQ   (56)(SI), R8, R11
+    MOVQ    (72)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (72)(CX)
+    MULXQ   (64)(SI), R8, R9
+    MOVQ    (80)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (80)(CX)
+    MULXQ   (72)(SI), R8, R11
+    MOVQ    (88)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (88)(CX)
+    ADCXQ   AX, R11
+    ADOXQ   AX, R11
+    MOVQ    R11, (96)(CX)
+    // z += x[3]*y*2^(64*3)
+    MOVQ    (24)(DI), DX
+    XORQ    AX, AX
+    MULXQ   (0)(SI), R8, R9
+    MOVQ    (24)(CX), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (24)(CX)
+    MULXQ   (8)(SI), R8, R11
+    MOVQ    (32)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (32)(CX)
+    MULXQ   (16)(SI), R8, R9
+    MOVQ    (40)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (40)(CX)
+    MULXQ   (24)(SI), R8, R11
+    MOVQ    (48)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (48)(CX)
+    MULXQ   (32)(SI), R8, R9
+    MOVQ    (56)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (56)(CX)
+    MULXQ   (40)(SI), R8, R11
+    MOVQ    (64)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (64)(CX)
+    MULXQ   (48)(SI), R8, R9
+    MOVQ    (72)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (72)(CX)
+    MULXQ   (56)(SI), R8, R11
+    MOVQ    (80)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (80)(CX)
+    MULXQ   (64)(SI), R8, R9
+    MOVQ    (88)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (88)(CX)
+    MULXQ   (72)(SI), R8, R11
+    MOVQ    (96)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (96)(CX)
+    ADCXQ   AX, R11
+    ADOXQ   AX, R11
+    MOVQ    R11, (104)(CX)
+    // z += x[4]*y*2^(64*4)
+    MOVQ    (32)(DI), DX
+    XORQ    AX, AX
+    MULXQ   (0)(SI), R8, R9
+    MOVQ    (32)(CX), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (32)(CX)
+    MULXQ   (8)(SI), R8, R11
+    MOVQ    (40)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (40)(CX)
+    MULXQ   (16)(SI), R8, R9
+    MOVQ    (48)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (48)(CX)
+    MULXQ   (24)(SI), R8, R11
+    MOVQ    (56)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (56)(CX)
+    MULXQ   (32)(SI), R8, R9
+    MOVQ    (64)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (64)(CX)
+    MULXQ   (40)(SI), R8, R11
+    MOVQ    (72)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (72)(CX)
+    MULXQ   (48)(SI), R8, R9
+    MOVQ    (80)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (80)(CX)
+    MULXQ   (56)(SI), R8, R11
+    MOVQ    (88)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (88)(CX)
+    MULXQ   (64)(SI), R8, R9
+    MOVQ    (96)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (96)(CX)
+    MULXQ   (72)(SI), R8, R11
+    MOVQ    (104)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (104)(CX)
+    ADCXQ   AX, R11
+    ADOXQ   AX, R11
+    MOVQ    R11, (112)(CX)
+    // z += x[5]*y*2^(64*5)
+    MOVQ    (40)(DI), DX
+    XORQ    AX, AX
+    MULXQ   (0)(SI), R8, R9
+    MOVQ    (40)(CX), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (40)(CX)
+    MULXQ   (8)(SI), R8, R11
+    MOVQ    (48)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (48)(CX)
+    MULXQ   (16)(SI), R8, R9
+    MOVQ    (56)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (56)(CX)
+    MULXQ   (24)(SI), R8, R11
+    MOVQ    (64)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (64)(CX)
+    MULXQ   (32)(SI), R8, R9
+    MOVQ    (72)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (72)(CX)
+    MULXQ   (40)(SI), R8, R11
+    MOVQ    (80)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (80)(CX)
+    MULXQ   (48)(SI), R8, R9
+    MOVQ    (88)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (88)(CX)
+    MULXQ   (56)(SI), R8, R11
+    MOVQ    (96)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (96)(CX)
+    MULXQ   (64)(SI), R8, R9
+    MOVQ    (104)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (104)(CX)
+    MULXQ   (72)(SI), R8, R11
+    MOVQ    (112)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (112)(CX)
+    ADCXQ   AX, R11
+    ADOXQ   AX, R11
+    MOVQ    R11, (120)(CX)
+    // z += x[6]*y*2^(64*6)
+    MOVQ    (48)(DI), DX
+    XORQ    AX, AX
+    MULXQ   (0)(SI), R8, R9
+    MOVQ    (48)(CX), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (48)(CX)
+    MULXQ   (8)(SI), R8, R11
+    MOVQ    (56)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (56)(CX)
+    MULXQ   (16)(SI), R8, R9
+    MOVQ    (64)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (64)(CX)
+    MULXQ   (24)(SI), R8, R11
+    MOVQ    (72)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (72)(CX)
+    MULXQ   (32)(SI), R8, R9
+    MOVQ    (80)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (80)(CX)
+    MULXQ   (40)(SI), R8, R11
+    MOVQ    (88)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (88)(CX)
+    MULXQ   (48)(SI), R8, R9
+    MOVQ    (96)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (96)(CX)
+    MULXQ   (56)(SI), R8, R11
+    MOVQ    (104)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (104)(CX)
+    MULXQ   (64)(SI), R8, R9
+    MOVQ    (112)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (112)(CX)
+    MULXQ   (72)(SI), R8, R11
+    MOVQ    (120)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (120)(CX)
+    ADCXQ   AX, R11
+    ADOXQ   AX, R11
+    MOVQ    R11, (128)(CX)
+    // z += x[7]*y*2^(64*7)
+    MOVQ    (56)(DI), DX
+    XORQ    AX, AX
+    MULXQ   (0)(SI), R8, R9
+    MOVQ    (56)(CX), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (56)(CX)
+    MULXQ   (8)(SI), R8, R11
+    MOVQ    (64)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (64)(CX)
+    MULXQ   (16)(SI), R8, R9
+    MOVQ    (72)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (72)(CX)
+    MULXQ   (24)(SI), R8, R11
+    MOVQ    (80)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (80)(CX)
+    MULXQ   (32)(SI), R8, R9
+    MOVQ    (88)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (88)(CX)
+    MULXQ   (40)(SI), R8, R11
+    MOVQ    (96)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (96)(CX)
+    MULXQ   (48)(SI), R8, R9
+    MOVQ    (104)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (104)(CX)
+    MULXQ   (56)(SI), R8, R11
+    MOVQ    (112)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (112)(CX)
+    MULXQ   (64)(SI), R8, R9
+    MOVQ    (120)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (120)(CX)
+    MULXQ   (72)(SI), R8, R11
+    MOVQ    (128)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (128)(CX)
+    ADCXQ   AX, R11
+    ADOXQ   AX, R11
+    MOVQ    R11, (136)(CX)
+    // z += x[8]*y*2^(64*8)
+    MOVQ    (64)(DI), DX
+    XORQ    AX, AX
+    MULXQ   (0)(SI), R8, R9
+    MOVQ    (64)(CX), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (64)(CX)
+    MULXQ   (8)(SI), R8, R11
+    MOVQ    (72)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (72)(CX)
+    MULXQ   (16)(SI), R8, R9
+    MOVQ    (80)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (80)(CX)
+    MULXQ   (24)(SI), R8, R11
+    MOVQ    (88)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (88)(CX)
+    MULXQ   (32)(SI), R8, R9
+    MOVQ    (96)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (96)(CX)
+    MULXQ   (40)(SI), R8, R11
+    MOVQ    (104)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (104)(CX)
+    MULXQ   (48)(SI), R8, R9
+    MOVQ    (112)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (112)(CX)
+    MULXQ   (56)(SI), R8, R11
+    MOVQ    (120)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (120)(CX)
+    MULXQ   (64)(SI), R8, R9
+    MOVQ    (128)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (128)(CX)
+    MULXQ   (72)(SI), R8, R11
+    MOVQ    (136)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (136)(CX)
+    ADCXQ   AX, R11
+    ADOXQ   AX, R11
+    MOVQ    R11, (144)(CX)
+    // z += x[9]*y*2^(64*9)
+    MOVQ    (72)(DI), DX
+    XORQ    AX, AX
+    MULXQ   (0)(SI), R8, R9
+    MOVQ    (72)(CX), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (72)(CX)
+    MULXQ   (8)(SI), R8, R11
+    MOVQ    (80)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (80)(CX)
+    MULXQ   (16)(SI), R8, R9
+    MOVQ    (88)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (88)(CX)
+    MULXQ   (24)(SI), R8, R11
+    MOVQ    (96)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (96)(CX)
+    MULXQ   (32)(SI), R8, R9
+    MOVQ    (104)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (104)(CX)
+    MULXQ   (40)(SI), R8, R11
+    MOVQ    (112)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (112)(CX)
+    MULXQ   (48)(SI), R8, R9
+    MOVQ    (120)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (120)(CX)
+    MULXQ   (56)(SI), R8, R11
+    MOVQ    (128)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (128)(CX)
+    MULXQ   (64)(SI), R8, R9
+    MOVQ    (136)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (136)(CX)
+    MULXQ   (72)(SI), R8, R11
+    MOVQ    (144)(CX), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (144)(CX)
+    ADCXQ   AX, R11
+    ADOXQ   AX, R11
+    MOVQ    R11, (152)(CX)
+    RET
+
+// Montgomery reduction. As p610+1 has 4 zero words, only the upper
+// 6 words of x[i]*(p610+1) are added to x at each step.
+TEXT ·rdcP610(SB),NOSPLIT,$0-16
+    MOVQ    z+0(FP), SI
+    MOVQ    x+8(FP), DI
+    CMPB    ·HasADXandBMI2(SB), $1
+    JE      redc_bdw
+
+    // x += x[0]*(p610+1)*2^(64*0)
+    MOVQ    (0)(DI), BX
+    XORQ    CX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+0))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (32)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+1))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (40)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+2))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (48)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+3))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (56)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+4))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (64)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+5))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (72)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    ADDQ    CX, (80)(DI)
+    ADCQ    $0, (88)(DI)
+    ADCQ    $0, (96)(DI)
+    ADCQ    $0, (104)(DI)
+    ADCQ    $0, (112)(DI)
+    ADCQ    $0, (120)(DI)
+    ADCQ    $0, (128)(DI)
+    ADCQ    $0, (136)(DI)
+    ADCQ    $0, (144)(DI)
+    ADCQ    $0, (152)(DI)
+    // x += x[1]*(p610+1)*2^(64*1)
+    MOVQ    (8)(DI), BX
+    XORQ    CX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+0))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (40)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+1))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (48)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+2))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (56)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+3))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (64)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+4))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (72)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+5))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (80)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    ADDQ    CX, (88)(DI)
+    ADCQ    $0, (96)(DI)
+    ADCQ    $0, (104)(DI)
+    ADCQ    $0, (112)(DI)
+    ADCQ    $0, (120)(DI)
+    ADCQ    $0, (128)(DI)
+    ADCQ    $0, (136)(DI)
+    ADCQ    $0, (144)(DI)
+    ADCQ    $0, (152)(DI)
+    // x += x[2]*(p610+1)*2^(64*2)
+    MOVQ    (16)(DI), BX
+    XORQ    CX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+0))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (48)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+1))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (56)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+2))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (64)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+3))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (72)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+4))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (80)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+5))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (88)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    ADDQ    CX, (96)(DI)
+    ADCQ    $0, (104)(DI)
+    ADCQ    $0, (112)(DI)
+    ADCQ    $0, (120)(DI)
+    ADCQ    $0, (128)(DI)
+    ADCQ    $0, (136)(DI)
+    ADCQ    $0, (144)(DI)
+    ADCQ    $0, (152)(DI)
+    // x += x[3]*(p610+1)*2^(64*3)
+    MOVQ    (24)(DI), BX
+    XORQ    CX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+0))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (56)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+1))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (64)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+2))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (72)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+3))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (80)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+4))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (88)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+5))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (96)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    ADDQ    CX, (104)(DI)
+    ADCQ    $0, (112)(DI)
+    ADCQ    $0, (120)(DI)
+    ADCQ    $0, (128)(DI)
+    ADCQ    $0, (136)(DI)
+    ADCQ    $0, (144)(DI)
+    ADCQ    $0, (152)(DI)
+    // x += x[4]*(p610+1)*2^(64*4)
+    MOVQ    (32)(DI), BX
+    XORQ    CX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+0))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (64)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+1))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (72)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+2))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (80)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+3))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (88)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+4))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (96)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+5))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (104)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    ADDQ    CX, (112)(DI)
+    ADCQ    $0, (120)(DI)
+    ADCQ    $0, (128)(DI)
+    ADCQ    $0, (136)(DI)
+    ADCQ    $0, (144)(DI)
+    ADCQ    $0, (152)(DI)
+    // x += x[5]*(p610+1)*2^(64*5)
+    MOVQ    (40)(DI), BX
+    XORQ    CX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+0))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (72)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+1))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (80)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+2))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (88)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+3))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (96)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+4))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (104)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+5))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (112)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    ADDQ    CX, (120)(DI)
+    ADCQ    $0, (128)(DI)
+    ADCQ    $0, (136)(DI)
+    ADCQ    $0, (144)(DI)
+    ADCQ    $0, (152)(DI)
+    // x += x[6]*(p610+1)*2^(64*6)
+    MOVQ    (48)(DI), BX
+    XORQ    CX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+0))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (80)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+1))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (88)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+2))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (96)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+3))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (104)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+4))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (112)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+5))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (120)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    ADDQ    CX, (128)(DI)
+    ADCQ    $0, (136)(DI)
+    ADCQ    $0, (144)(DI)
+    ADCQ    $0, (152)(DI)
+    // x += x[7]*(p610+1)*2^(64*7)
+    MOVQ    (56)(DI), BX
+    XORQ    CX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+0))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (88)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+1))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (96)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+2))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (104)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+3))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (112)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+4))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (120)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+5))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (128)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    ADDQ    CX, (136)(DI)
+    ADCQ    $0, (144)(DI)
+    ADCQ    $0, (152)(DI)
+    // x += x[8]*(p610+1)*2^(64*8)
+    MOVQ    (64)(DI), BX
+    XORQ    CX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+0))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (96)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+1))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (104)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+2))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (112)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+3))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (120)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+4))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (128)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+5))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (136)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    ADDQ    CX, (144)(DI)
+    ADCQ    $0, (152)(DI)
+    // x += x[9]*(p610+1)*2^(64*9)
+    MOVQ    (72)(DI), BX
+    XORQ    CX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+0))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (104)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+1))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (112)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+2))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (120)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+3))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (128)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+4))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (136)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    MOVQ    BX, AX
+    MULQ    ·P610p1+(8*(P610_P1_ZEROS+5))(SB)
+    ADDQ    CX, AX
+    ADCQ    $0, DX
+    ADDQ    AX, (144)(DI)
+    ADCQ    $0, DX
+    MOVQ    DX, CX
+    ADDQ    CX, (152)(DI)
+
+    JMP     redc_out
+
+// 610-bit montgomery reduction Uses MULX/ADOX/ADCX instructions
+// available on Broadwell micro-architectures and newer.
+redc_bdw:
+    // x += x[0]*(p610+1)*2^(64*0)
+    MOVQ    (0)(DI), DX
+    XORQ    AX, AX
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+0))(SB), R8, R9
+    MOVQ    (32)(DI), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (32)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+1))(SB), R8, R11
+    MOVQ    (40)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (40)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+2))(SB), R8, R9
+    MOVQ    (48)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (48)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+3))(SB), R8, R11
+    MOVQ    (56)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (56)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+4))(SB), R8, R9
+    MOVQ    (64)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (64)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+5))(SB), R8, R11
+    MOVQ    (72)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (72)(DI)
+    MOVQ    (80)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (80)(DI)
+    MOVQ    (88)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (88)(DI)
+    MOVQ    (96)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (96)(DI)
+    MOVQ    (104)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (104)(DI)
+    MOVQ    (112)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (112)(DI)
+    MOVQ    (120)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (120)(DI)
+    MOVQ    (128)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (128)(DI)
+    MOVQ    (136)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (136)(DI)
+    MOVQ    (144)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (144)(DI)
+    MOVQ    (152)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (152)(DI)
+    // x += x[1]*(p610+1)*2^(64*1)
+    MOVQ    (8)(DI), DX
+    XORQ    AX, AX
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+0))(SB), R8, R9
+    MOVQ    (40)(DI), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (40)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+1))(SB), R8, R11
+    MOVQ    (48)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (48)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+2))(SB), R8, R9
+    MOVQ    (56)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (56)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+3))(SB), R8, R11
+    MOVQ    (64)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (64)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+4))(SB), R8, R9
+    MOVQ    (72)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (72)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+5))(SB), R8, R11
+    MOVQ    (80)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (80)(DI)
+    MOVQ    (88)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (88)(DI)
+    MOVQ    (96)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (96)(DI)
+    MOVQ    (104)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (104)(DI)
+    MOVQ    (112)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (112)(DI)
+    MOVQ    (120)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (120)(DI)
+    MOVQ    (128)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (128)(DI)
+    MOVQ    (136)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (136)(DI)
+    MOVQ    (144)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (144)(DI)
+    MOVQ    (152)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (152)(DI)
+    // x += x[2]*(p610+1)*2^(64*2)
+    MOVQ    (16)(DI), DX
+    XORQ    AX, AX
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+0))(SB), R8, R9
+    MOVQ    (48)(DI), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (48)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+1))(SB), R8, R11
+    MOVQ    (56)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (56)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+2))(SB), R8, R9
+    MOVQ    (64)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (64)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+3))(SB), R8, R11
+    MOVQ    (72)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (72)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+4))(SB), R8, R9
+    MOVQ    (80)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (80)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+5))(SB), R8, R11
+    MOVQ    (88)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (88)(DI)
+    MOVQ    (96)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (96)(DI)
+    MOVQ    (104)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (104)(DI)
+    MOVQ    (112)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (112)(DI)
+    MOVQ    (120)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (120)(DI)
+    MOVQ    (128)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (128)(DI)
+    MOVQ    (136)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (136)(DI)
+    MOVQ    (144)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (144)(DI)
+    MOVQ    (152)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (152)(DI)
+    // x += x[3]*(p610+1)*2^(64*3)
+    MOVQ    (24)(DI), DX
+    XORQ    AX, AX
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+0))(SB), R8, R9
+    MOVQ    (56)(DI), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (56)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+1))(SB), R8, R11
+    MOVQ    (64)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (64)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+2))(SB), R8, R9
+    MOVQ    (72)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (72)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+3))(SB), R8, R11
+    MOVQ    (80)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (80)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+4))(SB), R8, R9
+    MOVQ    (88)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (88)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+5))(SB), R8, R11
+    MOVQ    (96)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (96)(DI)
+    MOVQ    (104)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (104)(DI)
+    MOVQ    (112)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (112)(DI)
+    MOVQ    (120)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (120)(DI)
+    MOVQ    (128)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (128)(DI)
+    MOVQ    (136)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (136)(DI)
+    MOVQ    (144)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (144)(DI)
+    MOVQ    (152)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (152)(DI)
+    // x += x[4]*(p610+1)*2^(64*4)
+    MOVQ    (32)(DI), DX
+    XORQ    AX, AX
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+0))(SB), R8, R9
+    MOVQ    (64)(DI), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (64)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+1))(SB), R8, R11
+    MOVQ    (72)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (72)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+2))(SB), R8, R9
+    MOVQ    (80)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (80)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+3))(SB), R8, R11
+    MOVQ    (88)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (88)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+4))(SB), R8, R9
+    MOVQ    (96)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (96)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+5))(SB), R8, R11
+    MOVQ    (104)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (104)(DI)
+    MOVQ    (112)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (112)(DI)
+    MOVQ    (120)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (120)(DI)
+    MOVQ    (128)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (128)(DI)
+    MOVQ    (136)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (136)(DI)
+    MOVQ    (144)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (144)(DI)
+    MOVQ    (152)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (152)(DI)
+    // x += x[5]*(p610+1)*2^(64*5)
+    MOVQ    (40)(DI), DX
+    XORQ    AX, AX
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+0))(SB), R8, R9
+    MOVQ    (72)(DI), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (72)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+1))(SB), R8, R11
+    MOVQ    (80)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (80)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+2))(SB), R8, R9
+    MOVQ    (88)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (88)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+3))(SB), R8, R11
+    MOVQ    (96)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (96)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+4))(SB), R8, R9
+    MOVQ    (104)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (104)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+5))(SB), R8, R11
+    MOVQ    (112)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (112)(DI)
+    MOVQ    (120)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (120)(DI)
+    MOVQ    (128)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (128)(DI)
+    MOVQ    (136)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (136)(DI)
+    MOVQ    (144)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (144)(DI)
+    MOVQ    (152)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (152)(DI)
+    // x += x[6]*(p610+1)*2^(64*6)
+    MOVQ    (48)(DI), DX
+    XORQ    AX, AX
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+0))(SB), R8, R9
+    MOVQ    (80)(DI), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (80)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+1))(SB), R8, R11
+    MOVQ    (88)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (88)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+2))(SB), R8, R9
+    MOVQ    (96)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (96)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+3))(SB), R8, R11
+    MOVQ    (104)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (104)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+4))(SB), R8, R9
+    MOVQ    (112)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (112)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+5))(SB), R8, R11
+    MOVQ    (120)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (120)(DI)
+    MOVQ    (128)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (128)(DI)
+    MOVQ    (136)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (136)(DI)
+    MOVQ    (144)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (144)(DI)
+    MOVQ    (152)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (152)(DI)
+    // x += x[7]*(p610+1)*2^(64*7)
+    MOVQ    (56)(DI), DX
+    XORQ    AX, AX
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+0))(SB), R8, R9
+    MOVQ    (88)(DI), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (88)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+1))(SB), R8, R11
+    MOVQ    (96)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (96)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+2))(SB), R8, R9
+    MOVQ    (104)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (104)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+3))(SB), R8, R11
+    MOVQ    (112)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (112)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+4))(SB), R8, R9
+    MOVQ    (120)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (120)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+5))(SB), R8, R11
+    MOVQ    (128)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (128)(DI)
+    MOVQ    (136)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (136)(DI)
+    MOVQ    (144)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (144)(DI)
+    MOVQ    (152)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (152)(DI)
+    // x += x[8]*(p610+1)*2^(64*8)
+    MOVQ    (64)(DI), DX
+    XORQ    AX, AX
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+0))(SB), R8, R9
+    MOVQ    (96)(DI), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (96)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+1))(SB), R8, R11
+    MOVQ    (104)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (104)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+2))(SB), R8, R9
+    MOVQ    (112)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (112)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+3))(SB), R8, R11
+    MOVQ    (120)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (120)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+4))(SB), R8, R9
+    MOVQ    (128)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (128)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+5))(SB), R8, R11
+    MOVQ    (136)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (136)(DI)
+    MOVQ    (144)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (144)(DI)
+    MOVQ    (152)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   AX, R10
+    MOVQ    R10, (152)(DI)
+    // x += x[9]*(p610+1)*2^(64*9)
+    MOVQ    (72)(DI), DX
+    XORQ    AX, AX
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+0))(SB), R8, R9
+    MOVQ    (104)(DI), R10
+    ADCXQ   R8, R10
+    MOVQ    R10, (104)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+1))(SB), R8, R11
+    MOVQ    (112)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (112)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+2))(SB), R8, R9
+    MOVQ    (120)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (120)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+3))(SB), R8, R11
+    MOVQ    (128)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (128)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+4))(SB), R8, R9
+    MOVQ    (136)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (136)(DI)
+    MULXQ   ·P610p1+(8*(P610_P1_ZEROS+5))(SB), R8, R11
+    MOVQ    (144)(DI), R10
+    ADCXQ   R8, R10
+    ADOXQ   R9, R10
+    MOVQ    R10, (144)(DI)
+    MOVQ    (152)(DI), R10
+    ADCXQ   AX, R10
+    ADOXQ   R11, R10
+    MOVQ    R10, (152)(DI)
+
+redc_out:
+    // z = x[10-19]
+    MOVQ    (80)(DI), R8
+    MOVQ    (88)(DI), R9
+    MOVQ    (96)(DI), R10
+    MOVQ    (104)(DI), R11
+    MOVQ    (112)(DI), R12
+    MOVQ    (120)(DI), R13
+    MOVQ    (128)(DI), R14
+    MOVQ    (136)(DI), R15
+    MOVQ    (144)(DI), AX
+    MOVQ    (152)(DI), BX
+    MOVQ    R8, (0)(SI)
+    MOVQ    R9, (8)(SI)
+    MOVQ    R10, (16)(SI)
+    MOVQ    R11, (24)(SI)
+    MOVQ    R12, (32)(SI)
+    MOVQ    R13, (40)(SI)
+    MOVQ    R14, (48)(SI)
+    MOVQ    R15, (56)(SI)
+    MOVQ    AX, (64)(SI)
+    MOVQ    BX, (72)(SI)
+    RET
@@ -0,0 +1,1451 @@
+// +build arm64,!noasm
+
+#include "textflag.h"
+
+TEXT ·cswapP610(SB), NOSPLIT, $0-17
+	MOVD	x+0(FP), R0
+	MOVD	y+8(FP), R1
+	MOVB	choice+16(FP), R2
+
+	// Set flags
+	// If choice is not 0 or 1, this implementation will swap completely
+	CMP	$0, R2
+
+	LDP	0(R0), (R3, R4)
+	LDP	0(R1), (R5, R6)
+	CSEL	EQ, R3, R5, R7
+	CSEL	EQ, R4, R6, R8
+	STP	(R7, R8), 0(R0)
+	CSEL	NE, R3, R5, R9
+	CSEL	NE, R4, R6, R10
+	STP	(R9, R10), 0(R1)
+
+	LDP	16(R0), (R3, R4)
+	LDP	16(R1), (R5, R6)
+	CSEL	EQ, R3, R5, R7
+	CSEL	EQ, R4, R6, R8
+	STP	(R7, R8), 16(R0)
+	CSEL	NE, R3, R5, R9
+	CSEL	NE, R4, R6, R10
+	STP	(R9, R10), 16(R1)
+
+	LDP	32(R0), (R3, R4)
+	LDP	32(R1), (R5, R6)
+	CSEL	EQ, R3, R5, R7
+	CSEL	EQ, R4, R6, R8
+	STP	(R7, R8), 32(R0)
+	CSEL	NE, R3, R5, R9
+	CSEL	NE, R4, R6, R10
+	STP	(R9, R10), 32(R1)
+
+	LDP	48(R0), (R3, R4)
+	LDP	48(R1), (R5, R6)
+	CSEL	EQ, R3, R5, R7
+	CSEL	EQ, R4, R6, R8
+	STP	(R7, R8), 48(R0)
+	CSEL	NE, R3, R5, R9
+	CSEL	NE, R4, R6, R10
+	STP	(R9, R10), 48(R1)
+
+	LDP	64(R0), (R3, R4)
+	LDP	64(R1), (R5, R6)
+	CSEL	EQ, R3, R5, R7
+	CSEL	EQ, R4, R6, R8
+	STP	(R7, R8), 64(R0)
+	CSEL	NE, R3, R5, R9
+	CSEL	NE, R4, R6, R10
+	STP	(R9, R10), 64(R1)
+
+	RET
+
+TEXT ·addP610(SB), NOSPLIT, $0-24
+	MOVD	z+0(FP), R2
+	MOVD	x+8(FP), R0
+	MOVD	y+16(FP), R1
+
+	// Add x and y and store result in R3-R12
+	LDP	0(R0), (R3, R4)
+	LDP	0(R1), (R13, R14)
+	ADDS	R13, R3
+	ADCS	R14, R4
+	LDP	16(R0), (R5, R6)
+	LDP	16(R1), (R13, R14)
+	ADCS	R13, R5
+	ADCS	R14, R6
+	LDP	32(R0), (R7, R8)
+	LDP	32(R1), (R13, R14)
+	ADCS	R13, R7
+	ADCS	R14, R8
+	LDP	48(R0), (R9, R10)
+	LDP	48(R1), (R13, R14)
+	ADCS	R13, R9
+	ADCS	R14, R10
+	LDP	64(R0), (R11, R12)
+	LDP	64(R1), (R13, R14)
+	ADCS	R13, R11
+	ADCS	R14, R12
+
+	// Subtract 2 * p610 from the result in R3-R12
+	LDP	·P610x2+0(SB), (R13, R14)
+	SUBS	R13, R3
+	SBCS	R14, R4
+	LDP	·P610x2+16(SB), (R13, R14)
+	SBCS	R13, R5
+	SBCS	R14, R6
+	LDP	·P610x2+32(SB), (R13, R14)
+	SBCS	R13, R7
+	SBCS	R14, R8
+	LDP	·P610x2+48(SB), (R13, R14)
+	SBCS	R13, R9
+	SBCS	R14, R10
+	LDP	·P610x2+64(SB), (R13, R14)
+	SBCS	R13, R11
+	SBCS	R14, R12
+	SBC	ZR, ZR, R19
+
+	// If the result is negative, R19 is all ones and 2 * p610 is added back
+	LDP	·P610x2+0(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADDS	R13, R3
+	ADCS	R14, R4
+	STP	(R3, R4), 0(R2)
+	LDP	·P610x2+16(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADCS	R13, R5
+	ADCS	R14, R6
+	STP	(R5, R6), 16(R2)
+	LDP	·P610x2+32(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADCS	R13, R7
+	ADCS	R14, R8
+	STP	(R7, R8), 32(R2)
+	LDP	·P610x2+48(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADCS	R13, R9
+	ADCS	R14, R10
+	STP	(R9, R10), 48(R2)
+	LDP	·P610x2+64(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADCS	R13, R11
+	ADCS	R14, R12
+	STP	(R11, R12), 64(R2)
+
+	RET
+
+TEXT ·subP610(SB), NOSPLIT, $0-24
+	MOVD	z+0(FP), R2
+	MOVD	x+8(FP), R0
+	MOVD	y+16(FP), R1
+
+	// Subtract y from x and store result in R3-R12
+	LDP	0(R0), (R3, R4)
+	LDP	0(R1), (R13, R14)
+	SUBS	R13, R3
+	SBCS	R14, R4
+	LDP	16(R0), (R5, R6)
+	LDP	16(R1), (R13, R14)
+	SBCS	R13, R5
+	SBCS	R14, R6
+	LDP	32(R0), (R7, R8)
+	LDP	32(R1), (R13, R14)
+	SBCS	R13, R7
+	SBCS	R14, R8
+	LDP	48(R0), (R9, R10)
+	LDP	48(R1), (R13, R14)
+	SBCS	R13, R9
+	SBCS	R14, R10
+	LDP	64(R0), (R11, R12)
+	LDP	64(R1), (R13, R14)
+	SBCS	R13, R11
+	SBCS	R14, R12
+	SBC	ZR, ZR, R19
+
+	// If the result is negative, R19 is all ones and 2 * p610 is added back
+	LDP	·P610x2+0(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADDS	R13, R3
+	ADCS	R14, R4
+	STP	(R3, R4), 0(R2)
+	LDP	·P610x2+16(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADCS	R13, R5
+	ADCS	R14, R6
+	STP	(R5, R6), 16(R2)
+	LDP	·P610x2+32(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADCS	R13, R7
+	ADCS	R14, R8
+	STP	(R7, R8), 32(R2)
+	LDP	·P610x2+48(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADCS	R13, R9
+	ADCS	R14, R10
+	STP	(R9, R10), 48(R2)
+	LDP	·P610x2+64(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADCS	R13, R11
+	ADCS	R14, R12
+	STP	(R11, R12), 64(R2)
+
+	RET
+
+// Compute z = x + y, without reducing mod p.
+TEXT ·adlP610(SB), NOSPLIT, $0-24
+	MOVD	z+0(FP), R2
+	MOVD	x+8(FP), R0
+	MOVD	y+16(FP), R1
+
+	LDP	0(R0), (R3, R4)
+	LDP	0(R1), (R5, R6)
+	ADDS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 0(R2)
+	LDP	16(R0), (R3, R4)
+	LDP	16(R1), (R5, R6)
+	ADCS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 16(R2)
+	LDP	32(R0), (R3, R4)
+	LDP	32(R1), (R5, R6)
+	ADCS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 32(R2)
+	LDP	48(R0), (R3, R4)
+	LDP	48(R1), (R5, R6)
+	ADCS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 48(R2)
+	LDP	64(R0), (R3, R4)
+	LDP	64(R1), (R5, R6)
+	ADCS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 64(R2)
+	LDP	80(R0), (R3, R4)
+	LDP	80(R1), (R5, R6)
+	ADCS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 80(R2)
+	LDP	96(R0), (R3, R4)
+	LDP	96(R1), (R5, R6)
+	ADCS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 96(R2)
+	LDP	112(R0), (R3, R4)
+	LDP	112(R1), (R5, R6)
+	ADCS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 112(R2)
+	LDP	128(R0), (R3, R4)
+	LDP	128(R1), (R5, R6)
+	ADCS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 128(R2)
+	LDP	144(R0), (R3, R4)
+	LDP	144(R1), (R5, R6)
+	ADCS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 144(R2)
+
+	RET
+
+// Compute z = x - y, without reducing mod p. If the result is
+// negative, p610 * 2^640 is added back.
+TEXT ·sulP610(SB), NOSPLIT, $0-24
+	MOVD	z+0(FP), R2
+	MOVD	x+8(FP), R0
+	MOVD	y+16(FP), R1
+
+	LDP	0(R0), (R3, R4)
+	LDP	0(R1), (R5, R6)
+	SUBS	R5, R3
+	SBCS	R6, R4
+	STP	(R3, R4), 0(R2)
+	LDP	16(R0), (R3, R4)
+	LDP	16(R1), (R5, R6)
+	SBCS	R5, R3
+	SBCS	R6, R4
+	STP	(R3, R4), 16(R2)
+	LDP	32(R0), (R3, R4)
+	LDP	32(R1), (R5, R6)
+	SBCS	R5, R3
+	SBCS	R6, R4
+	STP	(R3, R4), 32(R2)
+	LDP	48(R0), (R3, R4)
+	LDP	48(R1), (R5, R6)
+	SBCS	R5, R3
+	SBCS	R6, R4
+	STP	(R3, R4), 48(R2)
+	LDP	64(R0), (R3, R4)
+	LDP	64(R1), (R5, R6)
+	SBCS	R5, R3
+	SBCS	R6, R4
+	STP	(R3, R4), 64(R2)
+	LDP	80(R0), (R3, R4)
+	LDP	80(R1), (R5, R6)
+	SBCS	R5, R3
+	SBCS	R6, R4
+	STP	(R3, R4), 80(R2)
+	LDP	96(R0), (R3, R4)
+	LDP	96(R1), (R5, R6)
+	SBCS	R5, R3
+	SBCS	R6, R4
+	STP	(R3, R4), 96(R2)
+	LDP	112(R0), (R3, R4)
+	LDP	112(R1), (R5, R6)
+	SBCS	R5, R3
+	SBCS	R6, R4
+	STP	(R3, R4), 112(R2)
+	LDP	128(R0), (R3, R4)
+	LDP	128(R1), (R5, R6)
+	SBCS	R5, R3
+	SBCS	R6, R4
+	STP	(R3, R4), 128(R2)
+	LDP	144(R0), (R3, R4)
+	LDP	144(R1), (R5, R6)
+	SBCS	R5, R3
+	SBCS	R6, R4
+	STP	(R3, R4), 144(R2)
+	SBC	ZR, ZR, R19
+
+	// Add p610 to the upper half if x - y < 0
+	LDP	80(R2), (R3, R4)
+	LDP	·P610+0(SB), (R5, R6)
+	AND	R19, R5
+	AND	R19, R6
+	ADDS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 80(R2)
+	LDP	96(R2), (R3, R4)
+	LDP	·P610+16(SB), (R5, R6)
+	AND	R19, R5
+	AND	R19, R6
+	ADCS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 96(R2)
+	LDP	112(R2), (R3, R4)
+	LDP	·P610+32(SB), (R5, R6)
+	AND	R19, R5
+	AND	R19, R6
+	ADCS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 112(R2)
+	LDP	128(R2), (R3, R4)
+	LDP	·P610+48(SB), (R5, R6)
+	AND	R19, R5
+	AND	R19, R6
+	ADCS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 128(R2)
+	LDP	144(R2), (R3, R4)
+	LDP	·P610+64(SB), (R5, R6)
+	AND	R19, R5
+	AND	R19, R6
+	ADCS	R5, R3
+	ADCS	R6, R4
+	STP	(R3, R4), 144(R2)
+
+	RET
+
+// Product scanning (Comba) multiplication, columns are accumulated
+// in a 192-bit register triple.
+TEXT ·mulP610(SB), NOSPLIT, $0-24
+	MOVD	z+0(FP), R2
+	MOVD	x+8(FP), R0
+	MOVD	y+16(FP), R1
+
+	// Load x in R3-R12 and y in R13-R17, R19-R23
+	LDP	0(R0), (R3, R4)
+	LDP	16(R0), (R5, R6)
+	LDP	32(R0), (R7, R8)
+	LDP	48(R0), (R9, R10)
+	LDP	64(R0), (R11, R12)
+	LDP	0(R1), (R13, R14)
+	LDP	16(R1), (R15, R16)
+	LDP	32(R1), (R17, R19)
+	LDP	48(R1), (R20, R21)
+	LDP	64(R1), (R22, R23)
+
+	// z[0]
+	MUL	R3, R13, R25
+	UMULH	R3, R13, R26
+	MOVD	R25, R0
+	MOVD	R26, R1
+	MOVD	ZR, R24
+	MOVD	R0, 0(R2)
+	MOVD	ZR, R0
+	// z[1]
+	MUL	R3, R14, R25
+	UMULH	R3, R14, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R4, R13, R25
+	UMULH	R4, R13, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MOVD	R1, 8(R2)
+	MOVD	ZR, R1
+	// z[2]
+	MUL	R3, R15, R25
+	UMULH	R3, R15, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R4, R14, R25
+	UMULH	R4, R14, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R5, R13, R25
+	UMULH	R5, R13, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MOVD	R24, 16(R2)
+	MOVD	ZR, R24
+	// z[3]
+	MUL	R3, R16, R25
+	UMULH	R3, R16, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R4, R15, R25
+	UMULH	R4, R15, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R5, R14, R25
+	UMULH	R5, R14, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R6, R13, R25
+	UMULH	R6, R13, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MOVD	R0, 24(R2)
+	MOVD	ZR, R0
+	// z[4]
+	MUL	R3, R17, R25
+	UMULH	R3, R17, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R4, R16, R25
+	UMULH	R4, R16, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R5, R15, R25
+	UMULH	R5, R15, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R6, R14, R25
+	UMULH	R6, R14, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R7, R13, R25
+	UMULH	R7, R13, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MOVD	R1, 32(R2)
+	MOVD	ZR, R1
+	// z[5]
+	MUL	R3, R19, R25
+	UMULH	R3, R19, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R4, R17, R25
+	UMULH	R4, R17, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R5, R16, R25
+	UMULH	R5, R16, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R6, R15, R25
+	UMULH	R6, R15, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R7, R14, R25
+	UMULH	R7, R14, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R8, R13, R25
+	UMULH	R8, R13, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MOVD	R24, 40(R2)
+	MOVD	ZR, R24
+	// z[6]
+	MUL	R3, R20, R25
+	UMULH	R3, R20, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R4, R19, R25
+	UMULH	R4, R19, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R5, R17, R25
+	UMULH	R5, R17, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R6, R16, R25
+	UMULH	R6, R16, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R7, R15, R25
+	UMULH	R7, R15, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R8, R14, R25
+	UMULH	R8, R14, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R9, R13, R25
+	UMULH	R9, R13, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MOVD	R0, 48(R2)
+	MOVD	ZR, R0
+	// z[7]
+	MUL	R3, R21, R25
+	UMULH	R3, R21, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R4, R20, R25
+	UMULH	R4, R20, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R5, R19, R25
+	UMULH	R5, R19, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R6, R17, R25
+	UMULH	R6, R17, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R7, R16, R25
+	UMULH	R7, R16, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R8, R15, R25
+	UMULH	R8, R15, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R9, R14, R25
+	UMULH	R9, R14, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R10, R13, R25
+	UMULH	R10, R13, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MOVD	R1, 56(R2)
+	MOVD	ZR, R1
+	// z[8]
+	MUL	R3, R22, R25
+	UMULH	R3, R22, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R4, R21, R25
+	UMULH	R4, R21, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R5, R20, R25
+	UMULH	R5, R20, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R6, R19, R25
+	UMULH	R6, R19, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R7, R17, R25
+	UMULH	R7, R17, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R8, R16, R25
+	UMULH	R8, R16, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R9, R15, R25
+	UMULH	R9, R15, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R10, R14, R25
+	UMULH	R10, R14, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R11, R13, R25
+	UMULH	R11, R13, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MOVD	R24, 64(R2)
+	MOVD	ZR, R24
+	// z[9]
+	MUL	R3, R23, R25
+	UMULH	R3, R23, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R4, R22, R25
+	UMULH	R4, R22, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R5, R21, R25
+	UMULH	R5, R21, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R6, R20, R25
+	UMULH	R6, R20, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R7, R19, R25
+	UMULH	R7, R19, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R8, R17, R25
+	UMULH	R8, R17, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R9, R16, R25
+	UMULH	R9, R16, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R10, R15, R25
+	UMULH	R10, R15, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R11, R14, R25
+	UMULH	R11, R14, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R12, R13, R25
+	UMULH	R12, R13, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MOVD	R0, 72(R2)
+	MOVD	ZR, R0
+	// z[10]
+	MUL	R4, R23, R25
+	UMULH	R4, R23, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R5, R22, R25
+	UMULH	R5, R22, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R6, R21, R25
+	UMULH	R6, R21, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R7, R20, R25
+	UMULH	R7, R20, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R8, R19, R25
+	UMULH	R8, R19, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R9, R17, R25
+	UMULH	R9, R17, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R10, R16, R25
+	UMULH	R10, R16, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R11, R15, R25
+	UMULH	R11, R15, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R12, R14, R25
+	UMULH	R12, R14, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MOVD	R1, 80(R2)
+	MOVD	ZR, R1
+	// z[11]
+	MUL	R5, R23, R25
+	UMULH	R5, R23, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R6, R22, R25
+	UMULH	R6, R22, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R7, R21, R25
+	UMULH	R7, R21, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R8, R20, R25
+	UMULH	R8, R20, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R9, R19, R25
+	UMULH	R9, R19, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R10, R17, R25
+	UMULH	R10, R17, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R11, R16, R25
+	UMULH	R11, R16, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R12, R15, R25
+	UMULH	R12, R15, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MOVD	R24, 88(R2)
+	MOVD	ZR, R24
+	// z[12]
+	MUL	R6, R23, R25
+	UMULH	R6, R23, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R7, R22, R25
+	UMULH	R7, R22, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R8, R21, R25
+	UMULH	R8, R21, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R9, R20, R25
+	UMULH	R9, R20, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R10, R19, R25
+	UMULH	R10, R19, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R11, R17, R25
+	UMULH	R11, R17, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R12, R16, R25
+	UMULH	R12, R16, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MOVD	R0, 96(R2)
+	MOVD	ZR, R0
+	// z[13]
+	MUL	R7, R23, R25
+	UMULH	R7, R23, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R8, R22, R25
+	UMULH	R8, R22, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R9, R21, R25
+	UMULH	R9, R21, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R10, R20, R25
+	UMULH	R10, R20, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R11, R19, R25
+	UMULH	R11, R19, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R12, R17, R25
+	UMULH	R12, R17, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MOVD	R1, 104(R2)
+	MOVD	ZR, R1
+	// z[14]
+	MUL	R8, R23, R25
+	UMULH	R8, R23, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R9, R22, R25
+	UMULH	R9, R22, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R10, R21, R25
+	UMULH	R10, R21, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R11, R20, R25
+	UMULH	R11, R20, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R12, R19, R25
+	UMULH	R12, R19, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MOVD	R24, 112(R2)
+	MOVD	ZR, R24
+	// z[15]
+	MUL	R9, R23, R25
+	UMULH	R9, R23, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R10, R22, R25
+	UMULH	R10, R22, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R11, R21, R25
+	UMULH	R11, R21, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MUL	R12, R20, R25
+	UMULH	R12, R20, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MOVD	R0, 120(R2)
+	MOVD	ZR, R0
+	// z[16]
+	MUL	R10, R23, R25
+	UMULH	R10, R23, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R11, R22, R25
+	UMULH	R11, R22, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MUL	R12, R21, R25
+	UMULH	R12, R21, R26
+	ADDS	R25, R1
+	ADCS	R26, R24
+	ADC	ZR, R0
+	MOVD	R1, 128(R2)
+	MOVD	ZR, R1
+	// z[17]
+	MUL	R11, R23, R25
+	UMULH	R11, R23, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MUL	R12, R22, R25
+	UMULH	R12, R22, R26
+	ADDS	R25, R24
+	ADCS	R26, R0
+	ADC	ZR, R1
+	MOVD	R24, 136(R2)
+	MOVD	ZR, R24
+	// z[18]
+	MUL	R12, R23, R25
+	UMULH	R12, R23, R26
+	ADDS	R25, R0
+	ADCS	R26, R1
+	ADC	ZR, R24
+	MOVD	R0, 144(R2)
+	MOVD	ZR, R0
+	MOVD	R1, 152(R2)
+
+	RET
+
+// Montgomery reduction using product scanning. As p610+1 has 4 zero
+// words, only products with its upper 6 words are computed.
+TEXT ·rdcP610(SB), NOSPLIT, $0-16
+	MOVD	z+0(FP), R1
+	MOVD	x+8(FP), R0
+
+	// Load the upper words of p610+1 in R13-R17, R19
+	LDP	·P610p1+32(SB), (R13, R14)
+	LDP	·P610p1+48(SB), (R15, R16)
+	LDP	·P610p1+64(SB), (R17, R19)
+
+	MOVD	ZR, R20
+	MOVD	ZR, R21
+	MOVD	ZR, R22
+	// column 0
+	MOVD	0(R0), R23
+	ADDS	R23, R20
+	ADCS	ZR, R21
+	ADC	ZR, R22
+	MOVD	R20, R3
+	MOVD	ZR, R20
+	// column 1
+	MOVD	8(R0), R23
+	ADDS	R23, R21
+	ADCS	ZR, R22
+	ADC	ZR, R20
+	MOVD	R21, R4
+	MOVD	ZR, R21
+	// column 2
+	MOVD	16(R0), R23
+	ADDS	R23, R22
+	ADCS	ZR, R20
+	ADC	ZR, R21
+	MOVD	R22, R5
+	MOVD	ZR, R22
+	// column 3
+	MOVD	24(R0), R23
+	ADDS	R23, R20
+	ADCS	ZR, R21
+	ADC	ZR, R22
+	MOVD	R20, R6
+	MOVD	ZR, R20
+	// column 4
+	MUL	R3, R13, R23
+	UMULH	R3, R13, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MOVD	32(R0), R23
+	ADDS	R23, R21
+	ADCS	ZR, R22
+	ADC	ZR, R20
+	MOVD	R21, R7
+	MOVD	ZR, R21
+	// column 5
+	MUL	R3, R14, R23
+	UMULH	R3, R14, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R4, R13, R23
+	UMULH	R4, R13, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MOVD	40(R0), R23
+	ADDS	R23, R22
+	ADCS	ZR, R20
+	ADC	ZR, R21
+	MOVD	R22, R8
+	MOVD	ZR, R22
+	// column 6
+	MUL	R3, R15, R23
+	UMULH	R3, R15, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R4, R14, R23
+	UMULH	R4, R14, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R5, R13, R23
+	UMULH	R5, R13, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MOVD	48(R0), R23
+	ADDS	R23, R20
+	ADCS	ZR, R21
+	ADC	ZR, R22
+	MOVD	R20, R9
+	MOVD	ZR, R20
+	// column 7
+	MUL	R3, R16, R23
+	UMULH	R3, R16, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R4, R15, R23
+	UMULH	R4, R15, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R5, R14, R23
+	UMULH	R5, R14, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R6, R13, R23
+	UMULH	R6, R13, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MOVD	56(R0), R23
+	ADDS	R23, R21
+	ADCS	ZR, R22
+	ADC	ZR, R20
+	MOVD	R21, R10
+	MOVD	ZR, R21
+	// column 8
+	MUL	R3, R17, R23
+	UMULH	R3, R17, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R4, R16, R23
+	UMULH	R4, R16, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R5, R15, R23
+	UMULH	R5, R15, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R6, R14, R23
+	UMULH	R6, R14, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R7, R13, R23
+	UMULH	R7, R13, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MOVD	64(R0), R23
+	ADDS	R23, R22
+	ADCS	ZR, R20
+	ADC	ZR, R21
+	MOVD	R22, R11
+	MOVD	ZR, R22
+	// column 9
+	MUL	R3, R19, R23
+	UMULH	R3, R19, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R4, R17, R23
+	UMULH	R4, R17, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R5, R16, R23
+	UMULH	R5, R16, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R6, R15, R23
+	UMULH	R6, R15, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R7, R14, R23
+	UMULH	R7, R14, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R8, R13, R23
+	UMULH	R8, R13, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MOVD	72(R0), R23
+	ADDS	R23, R20
+	ADCS	ZR, R21
+	ADC	ZR, R22
+	MOVD	R20, R12
+	MOVD	ZR, R20
+	// column 10
+	MUL	R4, R19, R23
+	UMULH	R4, R19, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R5, R17, R23
+	UMULH	R5, R17, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R6, R16, R23
+	UMULH	R6, R16, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R7, R15, R23
+	UMULH	R7, R15, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R8, R14, R23
+	UMULH	R8, R14, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R9, R13, R23
+	UMULH	R9, R13, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MOVD	80(R0), R23
+	ADDS	R23, R21
+	ADCS	ZR, R22
+	ADC	ZR, R20
+	MOVD	R21, 0(R1)
+	MOVD	ZR, R21
+	// column 11
+	MUL	R5, R19, R23
+	UMULH	R5, R19, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R6, R17, R23
+	UMULH	R6, R17, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R7, R16, R23
+	UMULH	R7, R16, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R8, R15, R23
+	UMULH	R8, R15, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R9, R14, R23
+	UMULH	R9, R14, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R10, R13, R23
+	UMULH	R10, R13, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MOVD	88(R0), R23
+	ADDS	R23, R22
+	ADCS	ZR, R20
+	ADC	ZR, R21
+	MOVD	R22, 8(R1)
+	MOVD	ZR, R22
+	// column 12
+	MUL	R6, R19, R23
+	UMULH	R6, R19, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R7, R17, R23
+	UMULH	R7, R17, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R8, R16, R23
+	UMULH	R8, R16, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R9, R15, R23
+	UMULH	R9, R15, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R10, R14, R23
+	UMULH	R10, R14, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R11, R13, R23
+	UMULH	R11, R13, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MOVD	96(R0), R23
+	ADDS	R23, R20
+	ADCS	ZR, R21
+	ADC	ZR, R22
+	MOVD	R20, 16(R1)
+	MOVD	ZR, R20
+	// column 13
+	MUL	R7, R19, R23
+	UMULH	R7, R19, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R8, R17, R23
+	UMULH	R8, R17, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R9, R16, R23
+	UMULH	R9, R16, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R10, R15, R23
+	UMULH	R10, R15, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R11, R14, R23
+	UMULH	R11, R14, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R12, R13, R23
+	UMULH	R12, R13, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MOVD	104(R0), R23
+	ADDS	R23, R21
+	ADCS	ZR, R22
+	ADC	ZR, R20
+	MOVD	R21, 24(R1)
+	MOVD	ZR, R21
+	// column 14
+	MUL	R8, R19, R23
+	UMULH	R8, R19, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R9, R17, R23
+	UMULH	R9, R17, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R10, R16, R23
+	UMULH	R10, R16, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R11, R15, R23
+	UMULH	R11, R15, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R12, R14, R23
+	UMULH	R12, R14, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MOVD	112(R0), R23
+	ADDS	R23, R22
+	ADCS	ZR, R20
+	ADC	ZR, R21
+	MOVD	R22, 32(R1)
+	MOVD	ZR, R22
+	// column 15
+	MUL	R9, R19, R23
+	UMULH	R9, R19, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R10, R17, R23
+	UMULH	R10, R17, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R11, R16, R23
+	UMULH	R11, R16, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MUL	R12, R15, R23
+	UMULH	R12, R15, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MOVD	120(R0), R23
+	ADDS	R23, R20
+	ADCS	ZR, R21
+	ADC	ZR, R22
+	MOVD	R20, 40(R1)
+	MOVD	ZR, R20
+	// column 16
+	MUL	R10, R19, R23
+	UMULH	R10, R19, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R11, R17, R23
+	UMULH	R11, R17, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MUL	R12, R16, R23
+	UMULH	R12, R16, R24
+	ADDS	R23, R21
+	ADCS	R24, R22
+	ADC	ZR, R20
+	MOVD	128(R0), R23
+	ADDS	R23, R21
+	ADCS	ZR, R22
+	ADC	ZR, R20
+	MOVD	R21, 48(R1)
+	MOVD	ZR, R21
+	// column 17
+	MUL	R11, R19, R23
+	UMULH	R11, R19, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MUL	R12, R17, R23
+	UMULH	R12, R17, R24
+	ADDS	R23, R22
+	ADCS	R24, R20
+	ADC	ZR, R21
+	MOVD	136(R0), R23
+	ADDS	R23, R22
+	ADCS	ZR, R20
+	ADC	ZR, R21
+	MOVD	R22, 56(R1)
+	MOVD	ZR, R22
+	// column 18
+	MUL	R12, R19, R23
+	UMULH	R12, R19, R24
+	ADDS	R23, R20
+	ADCS	R24, R21
+	ADC	ZR, R22
+	MOVD	144(R0), R23
+	ADDS	R23, R20
+	ADCS	ZR, R21
+	ADC	ZR, R22
+	MOVD	R20, 64(R1)
+	MOVD	ZR, R20
+	// column 19
+	MOVD	152(R0), R23
+	ADD	R23, R21
+	MOVD	R21, 72(R1)
+
+	RET
+
+TEXT ·modP610(SB), NOSPLIT, $0-8
+	MOVD	x+0(FP), R0
+
+	// Keep x in R3-R12, subtract p610
+	LDP	0(R0), (R3, R4)
+	LDP	16(R0), (R5, R6)
+	LDP	32(R0), (R7, R8)
+	LDP	48(R0), (R9, R10)
+	LDP	64(R0), (R11, R12)
+	LDP	·P610+0(SB), (R13, R14)
+	SUBS	R13, R3
+	SBCS	R14, R4
+	LDP	·P610+16(SB), (R13, R14)
+	SBCS	R13, R5
+	SBCS	R14, R6
+	LDP	·P610+32(SB), (R13, R14)
+	SBCS	R13, R7
+	SBCS	R14, R8
+	LDP	·P610+48(SB), (R13, R14)
+	SBCS	R13, R9
+	SBCS	R14, R10
+	LDP	·P610+64(SB), (R13, R14)
+	SBCS	R13, R11
+	SBCS	R14, R12
+	SBC	ZR, ZR, R19
+
+	// If x - p610 < 0, R19 is all ones and p610 is added back
+	LDP	·P610+0(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADDS	R13, R3
+	ADCS	R14, R4
+	STP	(R3, R4), 0(R0)
+	LDP	·P610+16(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADCS	R13, R5
+	ADCS	R14, R6
+	STP	(R5, R6), 16(R0)
+	LDP	·P610+32(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADCS	R13, R7
+	ADCS	R14, R8
+	STP	(R7, R8), 32(R0)
+	LDP	·P610+48(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADCS	R13, R9
+	ADCS	R14, R10
+	STP	(R9, R10), 48(R0)
+	LDP	·P610+64(SB), (R13, R14)
+	AND	R19, R13
+	AND	R19, R14
+	ADCS	R13, R11
+	ADCS	R14, R12
+	STP	(R11, R12), 64(R0)
+
+	RET
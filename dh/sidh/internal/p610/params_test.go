@@ -0,0 +1,69 @@
+package p610
+
+// Contains values used by tests
+import (
+	"testing/quick"
+
+	. "github.com/cloudflare/circl/dh/sidh/internal/common"
+)
+
+// Values computed using Python
+var (
+	expectedJ = Fp2{
+		A: Fp{0x7A97486462E53A33, 0xE1AC9C8DCE70113C, 0x1855FE212F250123, 0x0115C1D32099E159, 0xE9E4E98AF51D0921, 0xA532BC6151D0443C, 0x933BC643442B06D1, 0xFC29F0B1E018661D, 0xD531855565EB6AF7, 0x00000001D7400453},
+		B: Fp{0x041E3D7DA8884B8A, 0x9F1B4707B30E74B0, 0x70170FD542E49945, 0xE69BA6CAB936D7EE, 0xA5CF74A3F9B554F9, 0xF617A0D98876E8BE, 0xA54269E1A633E3AD, 0x08190DD5D41C9006, 0x8EE84BE5EFB5A4E9, 0x0000000263A1309C}}
+
+	curveA = Fp2{
+		A: Fp{0xD16757100B1AE715, 0x4E8F7FEFE9AE111E, 0x5F8ED2D0FCC87983, 0x9BAB540FAB0FE251, 0x58179B18795EBD9D, 0x8FF1003283FF0443, 0xB9E6C58BC8330B88, 0x9D7490E708A408B6, 0x5A69A9B2102B58F5, 0x0000000116DC10F3},
+		B: Fp{0x00000000670CC8E6, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x9A34000000000000, 0x4D99C2BD28717A3F, 0x0A4A1839A323D41C, 0xD2B62215D06AD1E2, 0x1369026E862CAF3D, 0x000000010894E964}}
+
+	curveC = Fp2{
+		A: Fp{0xDB690B1728E515DC, 0xA567BAE9B8B6C1B1, 0x7EEE9792B1B53ECC, 0xDFA9DC8A6ACD47DB, 0x40DEA174B36AFB5B, 0x6298B3C788963A03, 0x6FD221A4ACDE46C6, 0xD325D022F53ADDBB, 0x4A6B1BD87E7F9C72, 0x00000000F7CF4568},
+		B: Fp{0x00000000CE1991CC, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x3468000000000000, 0x9B33857A50E2F47F, 0x149430734647A838, 0xA56C442BA0D5A3C4, 0x26D204DD0C595E7B, 0x000000021129D2C8}}
+
+	affineXP = Fp2{
+		A: Fp{0xB3A83F9FEA9B95BC, 0x3077A569AD507894, 0xEEE8CD924C3153E2, 0x3B2A3DB08A7674A8, 0xC30D2D7BFE808F95, 0x2D8FF2BF6513424A, 0xA8F9EEFA0D677F74, 0x5BC0E43085E24CCB, 0x37E1DD2D902FEEC2, 0x000000007E34915A},
+		B: Fp{0xA1EA7121E20FE26D, 0x01237DB1D5FA7957, 0xF3BCC60FA89EA90A, 0x317CFEE54A88D623, 0xAFD4D1016A0AE384, 0xBAFFAB4E3E0EF5A5, 0x1C56692F59DD982A, 0xF0D85607C69A65C4, 0x2A3129E9A2BFFBEC, 0x000000024E17BC43}}
+
+	affineXP2 = Fp2{
+		A: Fp{0x3E16A9814159A1E9, 0x7A02531A32A3D77C, 0x4EB30D70AB13134A, 0xCDB414AAB8CFBEED, 0x40213C6D564DC4F1, 0xFBBD875B1E0B7328, 0x29E0A4DFFA757DBA, 0x1C3C71A3D6F4D266, 0x38C166E072EECF46, 0x0000000186C49827},
+		B: Fp{0x19F2A9864B7F215D, 0x3E4BEA446EFA6E61, 0x879418A0647B9DF1, 0xCF7031AF6D3C47BD, 0x1035EDED59F3C90F, 0x5E0050758E59BD66, 0xD06046ADC4C1B41F, 0x0A390E944A7B37A7, 0x83AC48489921D60C, 0x000000019D1024AF}}
+
+	affineXP4 = Fp2{
+		A: Fp{0x43B3EA887D1EE6EB, 0x45652F63AE900A65, 0xA523C1B8192D1A56, 0xE81FB12B2182A70F, 0x459572FC60A2B11F, 0x465FC6F1CBDAEDB4, 0x904EA2CA96FDC4E5, 0x71E87A2643B2918D, 0x161926529E7AEDFD, 0x00000000CD7E63E9},
+		B: Fp{0x5ECD7ACEEA5744BD, 0x79EC443A93C9094B, 0x10C8BFCCA133431D, 0x259593F2110DA504, 0xC642664487E93208, 0x28D04B60C8F7CBA1, 0xDFBFB04DBD682B55, 0x9EF8C123264CFF34, 0xF0EBF14DF5C0F36B, 0x00000001EAF52955}}
+
+	affineXP9 = Fp2{
+		A: Fp{0xA23DB5C3975DB22B, 0x2899A333BC1354D0, 0xF22DCDFDDDC4F2D3, 0xFE7C92E5733E69E6, 0xAC4DA24DAD883205, 0x9654359355502CD4, 0xAF6E951D6885C79B, 0x1A40D02FF50017A4, 0x2820B8B835FB9C58, 0x00000000E8E85FEA},
+		B: Fp{0x097C41B920FD4C74, 0x18ED9EB9DA334D9E, 0x9EECF0E841A2C73E, 0x2B61507460AD9645, 0x4A26BF5B95F87794, 0x3AD050F5930CD86F, 0x363B01CC969C022F, 0xD508F21029ED2E3B, 0x330FF5E3BFDB1048, 0x00000001B1E2381B}}
+
+	// Inputs for testing 3-point-ladder
+	threePointLadderInputs = []ProjectivePoint{
+		// x(P)
+		{
+			X: Fp2{
+				A: Fp{0xB3A83F9FEA9B95BC, 0x3077A569AD507894, 0xEEE8CD924C3153E2, 0x3B2A3DB08A7674A8, 0xC30D2D7BFE808F95, 0x2D8FF2BF6513424A, 0xA8F9EEFA0D677F74, 0x5BC0E43085E24CCB, 0x37E1DD2D902FEEC2, 0x000000007E34915A},
+				B: Fp{0xA1EA7121E20FE26D, 0x01237DB1D5FA7957, 0xF3BCC60FA89EA90A, 0x317CFEE54A88D623, 0xAFD4D1016A0AE384, 0xBAFFAB4E3E0EF5A5, 0x1C56692F59DD982A, 0xF0D85607C69A65C4, 0x2A3129E9A2BFFBEC, 0x000000024E17BC43}},
+			Z: params.OneFp2,
+		},
+		// x(Q)
+		{
+			X: Fp2{
+				A: Fp{0xE717AD59D81F3D7A, 0x9B0CD8601FA1B575, 0x0BC139652D5695B2, 0xD28101CDB1649F67, 0xE2989F450E5B1A4A, 0xAFEB35A5E08D2F3B, 0xFBB83B63E96F5E8F, 0xC9C38E25D3A03F0E, 0x7BAFBE81924006F1, 0x00000001DE4A0735},
+				B: Fp{0xF99969EF442F4D5D, 0x9ADB1DD3954CDA97, 0x300835DB8BD97462, 0x96F53DD4164AC0E1, 0x799DBDE7646BC750, 0x2DBAE22E919565EB, 0x6F3C79E3863A23D2, 0x72B0DCAA4F95A3F7, 0x19F9364C7C19C396, 0x00000000EC18798A}},
+			Z: params.OneFp2,
+		},
+		// x(P-Q)
+		{
+			X: Fp2{
+				A: Fp{0x4E254C41BD5B9D61, 0xEDC6321835C0BFA4, 0x1D3567676FDE66BC, 0x60A4A6B7BB832893, 0x4B7C2126544131B1, 0x180FFCFEF00AB7F5, 0x274B028B6409ED4D, 0xC02AFDD5A0BF0F34, 0x1DD31E0EAD391209, 0x0000000216F85CB7},
+				B: Fp{0x45A09766E381B7B2, 0x1D1A36ECE361FDD0, 0x810D7C047C77CE3D, 0x17820D241CBDD696, 0x5BA4CBC1A7C52403, 0xC2F209B3CC62C7A6, 0x62267C4CE28070BE, 0x9A9C0D85F3A84CF5, 0x9F0563C71B3F44F3, 0x000000012B9FB94A}},
+			Z: params.OneFp2,
+		},
+	}
+	scalar3Pt = [...]uint8{0xaf, 0xba, 0x8c, 0x48, 0xae, 0x2a, 0x40, 0x81, 0xe5, 0xa8, 0xc2, 0x55, 0xa7, 0x9f, 0x76, 0x1c, 0x09, 0x0b, 0xa6, 0x0a, 0xd5, 0xb2, 0x95, 0x2c, 0x79, 0xcf, 0xf3, 0x7b, 0x48, 0xef, 0x52, 0xb2, 0x0e, 0x94, 0x03, 0x17, 0xe9, 0x4f}
+)
+
+var quickCheckConfig = &quick.Config{
+	MaxCount: (1 << 15),
+}
@@ -193,3 +193,46 @@ func FromMontgomery(out, in *common.Fp2) {
 	rdcP751(&out.B, &aR)
 	modP751(&out.B)
 }
+
+// Returns true if x is equal to zero. Value of x must be
+// in Montgomery domain.
+//
+// Not constant time, must be used only on public data.
+func isZero(x *common.Fp2) bool {
+	var t common.Fp2
+	var acc uint64
+
+	FromMontgomery(&t, x)
+	for i := range t.A {
+		acc |= t.A[i] | t.B[i]
+	}
+	return acc == 0
+}
+
+// Returns true if x is a square in Fp2. Uses the fact that a+bi is
+// a square in Fp2 iff its norm a^2+b^2 is a square in Fp, which is
+// checked by computing Legendre symbol (a^2+b^2)^((p-1)/2).
+//
+// Not constant time, must be used only on public data.
+func isSquare(x *common.Fp2) bool {
+	var e1, e2 common.FpX2
+	var n, l common.Fp2
+
+	mulP751(&e1, &x.A, &x.A) // = a*a*R*R
+	mulP751(&e2, &x.B, &x.B) // = b*b*R*R
+	adlP751(&e1, &e1, &e2)   // = (a^2 + b^2)*R*R
+	rdcP751(&n.A, &e1)       // = (a^2 + b^2)*R mod p
+
+	// l = n^((p-3)/4)^2 * n = n^((p-1)/2)
+	p34(&l.A, &n.A)
+	mulP(&l.A, &l.A, &l.A)
+	mulP(&l.A, &l.A, &n.A)
+
+	// l is either 0, 1 or -1
+	FromMontgomery(&l, &l)
+	acc := l.A[0] >> 1
+	for i := 1; i < len(l.A); i++ {
+		acc |= l.A[i]
+	}
+	return acc == 0
+}
@@ -1,12 +1,14 @@
 package sidh
 
 import (
+	"bytes"
 	"errors"
 	"io"
 
 	"github.com/cloudflare/circl/dh/sidh/internal/common"
 	"github.com/cloudflare/circl/dh/sidh/internal/p434"
 	"github.com/cloudflare/circl/dh/sidh/internal/p503"
+	"github.com/cloudflare/circl/dh/sidh/internal/p610"
 	"github.com/cloudflare/circl/dh/sidh/internal/p751"
 )
 
@@ -43,6 +45,7 @@ type PrivateKey struct {
 const (
 	Fp434 = common.Fp434
 	Fp503 = common.Fp503
+	Fp610 = common.Fp610
 	Fp751 = common.Fp751
 )
 
@@ -69,33 +72,135 @@ func NewPublicKey(id uint8, v KeyVariant) *PublicKey {
 	return &PublicKey{key: key{params: common.Params(id), keyVariant: v}}
 }
 
+// ImportOptions selects checks performed by ImportWithOptions.
+type ImportOptions struct {
+	// Validate enables validation of the public key. Encoding of each
+	// coordinate must be canonical, i.e. smaller than the prime, and the
+	// key must pass all the checks done by Validate.
+	Validate bool
+}
+
+var (
+	// ErrPublicKeyEncoding is returned when public key contains a coordinate
+	// which is not canonically encoded.
+	ErrPublicKeyEncoding = errors.New("sidh: non-canonical encoding of public key")
+	// ErrPublicKeyCurve is returned when public key doesn't define points on
+	// a supersingular curve.
+	ErrPublicKeyCurve = errors.New("sidh: public key not on a supersingular curve")
+	// ErrPublicKeyOrder is returned when points of the public key don't
+	// generate the full 2^e2 or 3^e3 torsion subgroup.
+	ErrPublicKeyOrder = errors.New("sidh: public key points of wrong order")
+)
+
 // Import clears content of the public key currently stored in the structure
 // and imports key stored in the byte string. Returns error in case byte string
 // size is wrong. Doesn't perform any validation.
 func (pub *PublicKey) Import(input []byte) error {
+	return pub.ImportWithOptions(input, nil)
+}
+
+// ImportWithOptions works as Import, but additionally validates the key in
+// case opts.Validate is set. Content of the structure is not changed if
+// validation fails.
+func (pub *PublicKey) ImportWithOptions(input []byte, opts *ImportOptions) error {
 	if len(input) != pub.Size() {
 		return errors.New("sidh: input to short")
 	}
+	var tmp = PublicKey{key: pub.key}
 	ssSz := pub.params.SharedSecretSize
-	common.BytesToFp2(&pub.affine3Pt[0], input[0:ssSz], pub.params.Bytelen)
-	common.BytesToFp2(&pub.affine3Pt[1], input[ssSz:2*ssSz], pub.params.Bytelen)
-	common.BytesToFp2(&pub.affine3Pt[2], input[2*ssSz:3*ssSz], pub.params.Bytelen)
+	common.BytesToFp2(&tmp.affine3Pt[0], input[0:ssSz], pub.params.Bytelen)
+	common.BytesToFp2(&tmp.affine3Pt[1], input[ssSz:2*ssSz], pub.params.Bytelen)
+	common.BytesToFp2(&tmp.affine3Pt[2], input[2*ssSz:3*ssSz], pub.params.Bytelen)
+	switch pub.params.ID {
+	case Fp434:
+		p434.ToMontgomery(&tmp.affine3Pt[0], &tmp.affine3Pt[0])
+		p434.ToMontgomery(&tmp.affine3Pt[1], &tmp.affine3Pt[1])
+		p434.ToMontgomery(&tmp.affine3Pt[2], &tmp.affine3Pt[2])
+	case Fp503:
+		p503.ToMontgomery(&tmp.affine3Pt[0], &tmp.affine3Pt[0])
+		p503.ToMontgomery(&tmp.affine3Pt[1], &tmp.affine3Pt[1])
+		p503.ToMontgomery(&tmp.affine3Pt[2], &tmp.affine3Pt[2])
+	case Fp610:
+		p610.ToMontgomery(&tmp.affine3Pt[0], &tmp.affine3Pt[0])
+		p610.ToMontgomery(&tmp.affine3Pt[1], &tmp.affine3Pt[1])
+		p610.ToMontgomery(&tmp.affine3Pt[2], &tmp.affine3Pt[2])
+	case Fp751:
+		p751.ToMontgomery(&tmp.affine3Pt[0], &tmp.affine3Pt[0])
+		p751.ToMontgomery(&tmp.affine3Pt[1], &tmp.affine3Pt[1])
+		p751.ToMontgomery(&tmp.affine3Pt[2], &tmp.affine3Pt[2])
+	default:
+		panic("Unsupported key")
+	}
+
+	if opts != nil && opts.Validate {
+		// Coordinates bigger than the prime are reduced when converted
+		// to Montgomery domain, hence exported key would differ.
+		var buf [common.MaxPublicKeySz]byte
+		tmp.Export(buf[:])
+		if !bytes.Equal(buf[:len(input)], input) {
+			return ErrPublicKeyEncoding
+		}
+		if err := tmp.Validate(); err != nil {
+			return err
+		}
+	}
+	pub.affine3Pt = tmp.affine3Pt
+	return nil
+}
+
+// Validate checks that x(P), x(Q) and x(P-Q) stored in the public key
+// correspond to points on a supersingular curve and that P and Q generate
+// the full torsion subgroup expected for the key variant, that is 3^e3
+// for KeyVariantSidhA keys and 2^e2 for KeyVariantSidhB and KeyVariantSike.
+// Returns ErrPublicKeyCurve or ErrPublicKeyOrder in case a check fails.
+// Canonical encoding can only be checked by ImportWithOptions, as key
+// is reduced on import.
+//
+// Validation is roughly as expensive as the public key generation.
+func (pub *PublicKey) Validate() error {
+	var curve common.ProjectiveCurveParameters
+	var isA = (pub.keyVariant & KeyVariantSidhA) == KeyVariantSidhA
+	var curveOk, orderOk bool
+
 	switch pub.params.ID {
 	case Fp434:
-		p434.ToMontgomery(&pub.affine3Pt[0], &pub.affine3Pt[0])
-		p434.ToMontgomery(&pub.affine3Pt[1], &pub.affine3Pt[1])
-		p434.ToMontgomery(&pub.affine3Pt[2], &pub.affine3Pt[2])
+		curveOk = p434.ValidateCurve(&curve, &pub.affine3Pt)
+		if curveOk && isA {
+			orderOk = p434.ValidateOrderA(&curve, &pub.affine3Pt)
+		} else if curveOk {
+			orderOk = p434.ValidateOrderB(&curve, &pub.affine3Pt)
+		}
 	case Fp503:
-		p503.ToMontgomery(&pub.affine3Pt[0], &pub.affine3Pt[0])
-		p503.ToMontgomery(&pub.affine3Pt[1], &pub.affine3Pt[1])
-		p503.ToMontgomery(&pub.affine3Pt[2], &pub.affine3Pt[2])
+		curveOk = p503.ValidateCurve(&curve, &pub.affine3Pt)
+		if curveOk && isA {
+			orderOk = p503.ValidateOrderA(&curve, &pub.affine3Pt)
+		} else if curveOk {
+			orderOk = p503.ValidateOrderB(&curve, &pub.affine3Pt)
+		}
+	case Fp610:
+		curveOk = p610.ValidateCurve(&curve, &pub.affine3Pt)
+		if curveOk && isA {
+			orderOk = p610.ValidateOrderA(&curve, &pub.affine3Pt)
+		} else if curveOk {
+			orderOk = p610.ValidateOrderB(&curve, &pub.affine3Pt)
+		}
 	case Fp751:
-		p751.ToMontgomery(&pub.affine3Pt[0], &pub.affine3Pt[0])
-		p751.ToMontgomery(&pub.affine3Pt[1], &pub.affine3Pt[1])
-		p751.ToMontgomery(&pub.affine3Pt[2], &pub.affine3Pt[2])
+		curveOk = p751.ValidateCurve(&curve, &pub.affine3Pt)
+		if curveOk && isA {
+			orderOk = p751.ValidateOrderA(&curve, &pub.affine3Pt)
+		} else if curveOk {
+			orderOk = p751.ValidateOrderB(&curve, &pub.affine3Pt)
+		}
 	default:
 		panic("Unsupported key")
 	}
+
+	if !curveOk {
+		return ErrPublicKeyCurve
+	}
+	if !orderOk {
+		return ErrPublicKeyOrder
+	}
 	return nil
 }
 
@@ -113,6 +218,10 @@ func (pub *PublicKey) Export(out []byte) {
 		p503.FromMontgomery(&feTmp[0], &pub.affine3Pt[0])
 		p503.FromMontgomery(&feTmp[1], &pub.affine3Pt[1])
 		p503.FromMontgomery(&feTmp[2], &pub.affine3Pt[2])
+	case Fp610:
+		p610.FromMontgomery(&feTmp[0], &pub.affine3Pt[0])
+		p610.FromMontgomery(&feTmp[1], &pub.affine3Pt[1])
+		p610.FromMontgomery(&feTmp[2], &pub.affine3Pt[2])
 	case Fp751:
 		p751.FromMontgomery(&feTmp[0], &pub.affine3Pt[0])
 		p751.FromMontgomery(&feTmp[1], &pub.affine3Pt[1])
@@ -210,12 +319,14 @@ func (prv *PrivateKey) Generate(rand io.Reader) error {
 		return err
 	}
 
-	prv.Scalar[len(prv.Scalar)-1] &= (1 << (dp.SecretBitLen % 8)) - 1
+	// Number of bits of the key-space which fall into the last byte.
+	lastBits := dp.SecretBitLen - 8*(uint(len(prv.Scalar))-1)
+	prv.Scalar[len(prv.Scalar)-1] &= (1 << lastBits) - 1
 	// Make sure scalar is SecretBitLen long. SIKE spec says that key
 	// space starts from 0, but I'm not comfortable with having low
 	// value scalars used for private keys. It is still secrure as per
 	// table 5.1 in [SIKE].
-	prv.Scalar[len(prv.Scalar)-1] |= 1 << ((dp.SecretBitLen % 8) - 1)
+	prv.Scalar[len(prv.Scalar)-1] |= 1 << (lastBits - 1)
 
 	return nil
 }
@@ -241,6 +352,12 @@ func (prv *PrivateKey) GeneratePublicKey(pub *PublicKey) {
 		} else {
 			p503.PublicKeyGenB(&pub.affine3Pt, prv.Scalar)
 		}
+	case Fp610:
+		if isA {
+			p610.PublicKeyGenA(&pub.affine3Pt, prv.Scalar)
+		} else {
+			p610.PublicKeyGenB(&pub.affine3Pt, prv.Scalar)
+		}
 	case Fp751:
 		if isA {
 			p751.PublicKeyGenA(&pub.affine3Pt, prv.Scalar)
@@ -277,6 +394,12 @@ func (prv *PrivateKey) DeriveSecret(ss []byte, pub *PublicKey) {
 		} else {
 			p503.DeriveSecretB(ss, prv.Scalar, &pub.affine3Pt)
 		}
+	case Fp610:
+		if isA {
+			p610.DeriveSecretA(ss, prv.Scalar, &pub.affine3Pt)
+		} else {
+			p610.DeriveSecretB(ss, prv.Scalar, &pub.affine3Pt)
+		}
 	case Fp751:
 		if isA {
 			p751.DeriveSecretA(ss, prv.Scalar, &pub.affine3Pt)
@@ -85,6 +85,44 @@ var tdataSidh = map[uint8]sidhVec{
 			"21CAA429A1490AE1D6E0CA9D6BC4BCD14B1CFE694226D03E8731E9E0B3760877" +
 			"7D56630B31298CC05B6FF6C1A08935312D8E95B8056AD7831A22",
 	},
+	// Generated by the same implementation as PQCkemKAT_524.rsp, hence
+	// not taken from the official SIKE submission.
+	Fp610: {
+		id:   Fp610,
+		name: "P-610",
+		PrA:  "71684EF4FD495C50315DFAD0AE9CDB0D2601D838CFF7478294D6D54023BE48E00F19F34EC15401",
+		PrB:  "003271531CF27285B8721ED5CB46853043B346A66CBA6CF765F1B0EAA40BF6723F327918FF97",
+		PkA: "9FDF4931995482D5747C72728E3A198064B7AA98349C25E53BB75EA9F17B9867" +
+			"DDB406616D9678CBEA6D746AC0D6EB4333E94BD293381DF4434D34576BE40303" +
+			"D539C335EFC7148FF2FE3D520275059B8BE46C81587BDC8973BAA3C58C271104" +
+			"113DF1E0C308D6E2094B8F9ABB45DB5AB87A32AA3269F47DCFD8AA17988EF350" +
+			"61F47DBB3A4E289A343B35AFBC9D98CE1B2B1B6649FAF3761802B82A1D8A33B9" +
+			"CBE7C959ECB7529F573E088B53BD4CD34504EDE38EF4F06EA1E56C98F4A54013" +
+			"831D560CC969A0862BFA7B5E825A9F8B21425B4BFC009FB430E37E12D8A8CAF6" +
+			"072E16910877018A2993DE6F2FB13411C69F780760D301847EF7A59E9EF09822" +
+			"E41B45E77220D895C5C34B73E39EE021ED64B82314F1BBE4C8EB89BD962C893D" +
+			"EC7C092F267E15246B8CE54DCC4B7625C5DD080211EC63B63B90AE1AE6DF31A8" +
+			"A9B3A3EAEA914C791951BF942AEC310E89010B28CCDC43CA85401BCDFB452D43" +
+			"D1718789FFDE51ACE896CE5E779345269F6E54FFE39421976D59DEF28C9B8837" +
+			"02AD1893044E892A019895F54959B10E811953EC51641487027AD9B43F4F01F1" +
+			"2DD869E3B0D99C6E9FD1B5FE43F6C9D8EBA402FF624C14E89F01BEDBCB781EFC" +
+			"F895C8D80C810972DEC74AD6BE01",
+		PkB: "9C8E8A3272C3FB87014544E1A0E202E11B891BEFD4208F268F76A9B43622CC20" +
+			"FAD8FFDBFEDC075C1D38F76E3881C4007CF96BAC54F7346BE17F2E2A03540A26" +
+			"D6A7CA10FCD670D3E283CA4E02CB070DBD95C961D2FADF3619908101C051CBFF" +
+			"DDBA32AE756DA93B06BB09DBF06CB9A417665036792A4CC241BF72BA7551FDA0" +
+			"ABCD9E9CFDB32D8229A8D94B8EF1755FF8FEC4F76FB513F39401EAC4D3833E06" +
+			"63BB83266BAB38A8FD7E4113E166F2CAD7C33F7C1C28AD30BF387C381B489A45" +
+			"7B1BDEF438E081136A9DD493D84573CA0CE2EA843DEEC2A638FA2578B2072FF2" +
+			"FB71536A93CA005FA75A2718AD646AF6AEE31215752734667AB8AB5B988AC4A6" +
+			"0D56BBC937E888E60FEC587A5B5455A68E361929F969F168DC03C7A6F66766A0" +
+			"9DDD106BF3D96CBFC83674030FD3AD4EA6BA6601B0A20A4C19414433A4FFB36E" +
+			"582C1293B66BBE805F5CD22683DD256279D43CDC47B75B7B4BE5A0B3D44E0D58" +
+			"E9BF22A85AB938FB52FA79EF6596914E5A50C39BCEC5B6085104C889747F9294" +
+			"01493E4A8F4EEEE435F7B6D12AC92C23CEDFE5A67C337823589177C9E2A24DE7" +
+			"1144FB8A51A9099EADA00ED36FEF7E69F11464BC2D4AA8442CA817490AC65F76" +
+			"7D11B16B994407092773F2609F00",
+	},
 	Fp751: {
 		id:   Fp751,
 		name: "P-751",
@@ -297,6 +335,242 @@ func testImportExport(t *testing.T, v sidhVec) {
 	}
 }
 
+// fp2Big implements arithmetic in GF(p^2) = GF(p)[i]/(i^2+1) with big.Int.
+// It is used to build public keys with points of a given order on the curve
+// of a valid public key.
+type fp2Big struct{ p *big.Int }
+
+type fp2Elt [2]*big.Int
+
+func (f fp2Big) add(x, y fp2Elt) fp2Elt {
+	return fp2Elt{
+		new(big.Int).Mod(new(big.Int).Add(x[0], y[0]), f.p),
+		new(big.Int).Mod(new(big.Int).Add(x[1], y[1]), f.p)}
+}
+
+func (f fp2Big) sub(x, y fp2Elt) fp2Elt {
+	return fp2Elt{
+		new(big.Int).Mod(new(big.Int).Sub(x[0], y[0]), f.p),
+		new(big.Int).Mod(new(big.Int).Sub(x[1], y[1]), f.p)}
+}
+
+func (f fp2Big) mul(x, y fp2Elt) fp2Elt {
+	a := new(big.Int).Mul(x[0], y[0])
+	a.Sub(a, new(big.Int).Mul(x[1], y[1]))
+	b := new(big.Int).Mul(x[0], y[1])
+	b.Add(b, new(big.Int).Mul(x[1], y[0]))
+	return fp2Elt{a.Mod(a, f.p), b.Mod(b, f.p)}
+}
+
+func (f fp2Big) inv(x fp2Elt) fp2Elt {
+	n := new(big.Int).Mul(x[0], x[0])
+	n.Add(n, new(big.Int).Mul(x[1], x[1]))
+	n.ModInverse(n, f.p)
+	b := new(big.Int).Neg(x[1])
+	return fp2Elt{
+		new(big.Int).Mod(new(big.Int).Mul(x[0], n), f.p),
+		new(big.Int).Mod(new(big.Int).Mul(b, n), f.p)}
+}
+
+func (f fp2Big) fromInt(x int64) fp2Elt { return fp2Elt{big.NewInt(x), big.NewInt(0)} }
+
+// Decodes the i-th coordinate of a public key.
+func (f fp2Big) fromBytes(pk []byte, i, bytelen int) fp2Elt {
+	var x fp2Elt
+	for j := range x {
+		le := pk[(2*i+j)*bytelen : (2*i+j+1)*bytelen]
+		be := make([]byte, bytelen)
+		for k := range le {
+			be[k] = le[bytelen-1-k]
+		}
+		x[j] = new(big.Int).SetBytes(be)
+	}
+	return x
+}
+
+// Encodes x as the i-th coordinate of a public key.
+func (f fp2Big) toBytes(pk []byte, i, bytelen int, x fp2Elt) {
+	for j := range x {
+		be := x[j].Bytes()
+		le := pk[(2*i+j)*bytelen : (2*i+j+1)*bytelen]
+		for k := range le {
+			le[k] = 0
+		}
+		for k := range be {
+			le[k] = be[len(be)-1-k]
+		}
+	}
+}
+
+// Recovers the coefficient A of the Montgomery curve y^2 = x^3 + Ax^2 + x
+// from x(P), x(Q) and x(P-Q).
+func (f fp2Big) recoverA(xP, xQ, xR fp2Elt) fp2Elt {
+	one := f.fromInt(1)
+	t := f.sub(one, f.mul(xP, xQ))
+	t = f.sub(t, f.mul(xP, xR))
+	t = f.sub(t, f.mul(xQ, xR))
+	t = f.mul(t, t)
+	d := f.mul(f.mul(f.fromInt(4), xP), f.mul(xQ, xR))
+	t = f.mul(t, f.inv(d))
+	return f.sub(f.sub(f.sub(t, xP), xQ), xR)
+}
+
+// Returns x([2]P) = (x^2-1)^2 / 4x(x^2+Ax+1).
+func (f fp2Big) xDbl(A, x fp2Elt) fp2Elt {
+	one := f.fromInt(1)
+	x2 := f.mul(x, x)
+	n := f.sub(x2, one)
+	n = f.mul(n, n)
+	d := f.add(f.add(x2, f.mul(A, x)), one)
+	d = f.mul(f.mul(f.fromInt(4), x), d)
+	return f.mul(n, f.inv(d))
+}
+
+// Returns x([3]P) = x([2]P + P), computed with the differential addition
+// x(P+Q) = (x(P)x(Q)-1)^2 / (x(P)-x(Q))^2 x(P-Q).
+func (f fp2Big) xTpl(A, x fp2Elt) fp2Elt {
+	x2 := f.xDbl(A, x)
+	n := f.sub(f.mul(x, x2), f.fromInt(1))
+	n = f.mul(n, n)
+	d := f.sub(x, x2)
+	d = f.mul(f.mul(d, d), x)
+	return f.mul(n, f.inv(d))
+}
+
+func testValidate(t *testing.T, v sidhVec) {
+	var opts = ImportOptions{Validate: true}
+	var prms = common.Params(v.id)
+	// Characteristic of the field, p = 2^e2*3^e3 - 1
+	p := new(big.Int).Lsh(big.NewInt(1), prms.A.SecretBitLen)
+	p.Mul(p, new(big.Int).Exp(big.NewInt(3), big.NewInt(int64(len(prms.B.IsogenyStrategy)+1)), nil))
+	p.Sub(p, big.NewInt(1))
+
+	for _, key := range []struct {
+		hex     string
+		variant KeyVariant
+	}{{v.PkA, KeyVariantSidhA}, {v.PkB, KeyVariantSidhB}, {v.PkB, KeyVariantSike}} {
+		pkBytes, err := hex.DecodeString(key.hex)
+		CheckNoErr(t, err, "invalid hex-number provided")
+
+		pub := NewPublicKey(v.id, key.variant)
+		CheckNoErr(t, pub.ImportWithOptions(pkBytes, &opts), "validation of correct key failed")
+		CheckNoErr(t, pub.Validate(), "validation of correct key failed")
+
+		// Replace xP.A with xP.A + p, which is reduced by Import
+		xPA := make([]byte, prms.Bytelen)
+		for i := range xPA {
+			xPA[i] = pkBytes[prms.Bytelen-1-i]
+		}
+		xPA = new(big.Int).Add(p, new(big.Int).SetBytes(xPA)).Bytes()
+		nonCanonical := append([]byte{}, pkBytes...)
+		for i := range xPA {
+			nonCanonical[i] = xPA[len(xPA)-1-i]
+		}
+		err = pub.ImportWithOptions(nonCanonical, &opts)
+		if err != ErrPublicKeyEncoding {
+			ReportError(t, err, ErrPublicKeyEncoding, "non-canonical encoding")
+		}
+		// Key must stay unchanged, Import without validation reduces xP.A
+		exp := make([]byte, pub.Size())
+		pub.Export(exp)
+		if !bytes.Equal(exp, pkBytes) {
+			t.Fatalf("failed import changed the key")
+		}
+		CheckNoErr(t, pub.Import(nonCanonical), "import failed")
+		pub.Export(exp)
+		if !bytes.Equal(exp, pkBytes) {
+			t.Fatalf("non-canonical value not reduced")
+		}
+
+		// Changing x(P) changes recovered curve
+		invalid := append([]byte{}, pkBytes...)
+		invalid[0] ^= 1
+		err = pub.ImportWithOptions(invalid, &opts)
+		if err != ErrPublicKeyCurve {
+			ReportError(t, err, ErrPublicKeyCurve, "changed x(P)")
+		}
+
+		// x(P) = 0
+		for i := 0; i < 2*prms.Bytelen; i++ {
+			invalid[i] = 0
+		}
+		err = pub.ImportWithOptions(invalid, &opts)
+		if err != ErrPublicKeyCurve {
+			ReportError(t, err, ErrPublicKeyCurve, "x(P) equal to zero")
+		}
+	}
+
+	// Points of the public key generated by Alice are of order 3^e3
+	pub := NewPublicKey(v.id, KeyVariantSidhB)
+	pkBytes, err := hex.DecodeString(v.PkA)
+	CheckNoErr(t, err, "invalid hex-number provided")
+	err = pub.ImportWithOptions(pkBytes, &opts)
+	if err != ErrPublicKeyOrder {
+		ReportError(t, err, ErrPublicKeyOrder, "wrong order")
+	}
+	pub = NewPublicKey(v.id, KeyVariantSidhA)
+	pkBytes, err = hex.DecodeString(v.PkB)
+	CheckNoErr(t, err, "invalid hex-number provided")
+	CheckNoErr(t, pub.Import(pkBytes), "import failed")
+	err = pub.Validate()
+	if err != ErrPublicKeyOrder {
+		ReportError(t, err, ErrPublicKeyOrder, "wrong order")
+	}
+
+	// Points on the curve of a valid key which are dependent, or which have
+	// too low order, are rejected.
+	f := fp2Big{p}
+	for _, key := range []struct {
+		hex     string
+		variant KeyVariant
+		// Multiplication by the prime dividing the order of the points,
+		// and by the other one
+		mul, other func(A, x fp2Elt) fp2Elt
+	}{{v.PkA, KeyVariantSidhA, f.xTpl, f.xDbl}, {v.PkB, KeyVariantSidhB, f.xDbl, f.xTpl}} {
+		pkBytes, err := hex.DecodeString(key.hex)
+		CheckNoErr(t, err, "invalid hex-number provided")
+		xP := f.fromBytes(pkBytes, 0, prms.Bytelen)
+		xQ := f.fromBytes(pkBytes, 1, prms.Bytelen)
+		xR := f.fromBytes(pkBytes, 2, prms.Bytelen)
+		A := f.recoverA(xP, xQ, xR)
+
+		// Multiplying by the other prime keeps the order of the points
+		valid := append([]byte{}, pkBytes...)
+		f.toBytes(valid, 0, prms.Bytelen, key.other(A, xP))
+		f.toBytes(valid, 1, prms.Bytelen, key.other(A, xQ))
+		f.toBytes(valid, 2, prms.Bytelen, key.other(A, xR))
+		pub := NewPublicKey(v.id, key.variant)
+		CheckNoErr(t, pub.ImportWithOptions(valid, &opts), "validation of correct key failed")
+
+		// Q = -P, so that P-Q = [2]P
+		invalid := append([]byte{}, pkBytes...)
+		f.toBytes(invalid, 1, prms.Bytelen, xP)
+		f.toBytes(invalid, 2, prms.Bytelen, f.xDbl(A, xP))
+		err = pub.ImportWithOptions(invalid, &opts)
+		if err != ErrPublicKeyOrder {
+			ReportError(t, err, ErrPublicKeyOrder, "dependent points", key.variant)
+		}
+
+		// [l]P, [l]Q and [l](P-Q) for l = 3 or l = 2
+		f.toBytes(invalid, 0, prms.Bytelen, key.mul(A, xP))
+		f.toBytes(invalid, 1, prms.Bytelen, key.mul(A, xQ))
+		f.toBytes(invalid, 2, prms.Bytelen, key.mul(A, xR))
+		err = pub.ImportWithOptions(invalid, &opts)
+		if err != ErrPublicKeyOrder {
+			ReportError(t, err, ErrPublicKeyOrder, "points of low order", key.variant)
+		}
+	}
+
+	// Randomly generated keys
+	for _, variant := range []KeyVariant{KeyVariantSidhA, KeyVariantSidhB, KeyVariantSike} {
+		prv := NewPrivateKey(v.id, variant)
+		pub := NewPublicKey(v.id, variant)
+		CheckNoErr(t, prv.Generate(rand.Reader), "key generation failed")
+		prv.GeneratePublicKey(pub)
+		CheckNoErr(t, pub.Validate(), "validation of generated key failed")
+	}
+}
+
 func testPrivateKeyBelowMax(t *testing.T, vec sidhVec) {
 	for variant, keySz := range map[KeyVariant]*common.DomainParams{
 		KeyVariantSidhA: &common.Params(vec.id).A,
@@ -334,6 +608,36 @@ func testPrivateKeyBelowMax(t *testing.T, vec sidhVec) {
 	}
 }
 
+// Checks that Generate doesn't drop any bits of the key-space. With a rand
+// source returning only 0xFF bytes, the generated scalar must be exactly
+// 2^SecretBitLen - 1. For the existing parameter sets this is the same as
+// masking the last byte with 2^(SecretBitLen%8) - 1. It differs only when
+// SecretBitLen is a multiple of 8 and the scalar has no spare byte (SIKE/p610
+// B, 304 bits in 38 bytes), where the old mask cleared the whole top byte.
+func testPrivateKeyMaxValue(t *testing.T, vec sidhVec) {
+	for variant, dp := range map[KeyVariant]*common.DomainParams{
+		KeyVariantSidhA: &common.Params(vec.id).A,
+		KeyVariantSidhB: &common.Params(vec.id).B} {
+
+		var prv = NewPrivateKey(vec.id, variant)
+		var secretBytes = make([]byte, prv.Size())
+		var src = bytes.NewReader(bytes.Repeat([]byte{0xFF}, prv.Size()))
+		CheckNoErr(t, prv.Generate(src), "Private key generation")
+
+		// Convert to big-endian, as that's what expected by (*Int)SetBytes()
+		prv.Export(secretBytes)
+		for i, j := 0, len(secretBytes)-1; i < j; i, j = i+1, j-1 {
+			secretBytes[i], secretBytes[j] = secretBytes[j], secretBytes[i]
+		}
+		got := new(big.Int).SetBytes(secretBytes)
+		want := new(big.Int).Lsh(big.NewInt(1), dp.SecretBitLen)
+		want.Sub(want, big.NewInt(1))
+		if got.Cmp(want) != 0 {
+			ReportError(t, got.Text(16), want.Text(16), variant)
+		}
+	}
+}
+
 func TestKeyAgreementP751_AliceEvenNumber(t *testing.T) {
 	// even alice
 	v := tdataSidh[Fp751]
@@ -373,6 +677,8 @@ func TestRoundtrip(t *testing.T)          { testSidhVec(t, &tdataSidh, testRound
 func TestImportExport(t *testing.T)       { testSidhVec(t, &tdataSidh, testImportExport) }
 func TestKeyAgreement(t *testing.T)       { testSidhVec(t, &tdataSidh, testKeyAgreement) }
 func TestPrivateKeyBelowMax(t *testing.T) { testSidhVec(t, &tdataSidh, testPrivateKeyBelowMax) }
+func TestPrivateKeyMaxValue(t *testing.T) { testSidhVec(t, &tdataSidh, testPrivateKeyMaxValue) }
+func TestValidate(t *testing.T)           { testSidhVec(t, &tdataSidh, testValidate) }
 
 /* -------------------------------------------------------------------------
    Benchmarking
@@ -392,6 +698,21 @@ func BenchmarkSidhKeyAgreementP751(b *testing.B) {
 	}
 }
 
+func BenchmarkSidhKeyAgreementP610(b *testing.B) {
+	// KeyPairs
+	alicePublic := convToPub(tdataSidh[Fp610].PkA, KeyVariantSidhA, Fp610)
+	bobPublic := convToPub(tdataSidh[Fp610].PkB, KeyVariantSidhB, Fp610)
+	alicePrivate := convToPrv(tdataSidh[Fp610].PrA, KeyVariantSidhA, Fp610)
+	bobPrivate := convToPrv(tdataSidh[Fp610].PrB, KeyVariantSidhB, Fp610)
+	var ss [2 * 77]byte
+
+	for i := 0; i < b.N; i++ {
+		// Derive shared secret
+		bobPrivate.DeriveSecret(ss[:], alicePublic)
+		alicePrivate.DeriveSecret(ss[:], bobPublic)
+	}
+}
+
 func BenchmarkSidhKeyAgreementP503(b *testing.B) {
 	// KeyPairs
 	alicePublic := convToPub(tdataSidh[Fp503].PkA, KeyVariantSidhA, Fp503)
@@ -429,6 +750,13 @@ func BenchmarkAliceKeyGenPrvP751(b *testing.B) {
 	}
 }
 
+func BenchmarkAliceKeyGenPrvP610(b *testing.B) {
+	prv := NewPrivateKey(Fp610, KeyVariantSidhA)
+	for n := 0; n < b.N; n++ {
+		prv.Generate(rand.Reader)
+	}
+}
+
 func BenchmarkAliceKeyGenPrvP503(b *testing.B) {
 	prv := NewPrivateKey(Fp503, KeyVariantSidhA)
 	for n := 0; n < b.N; n++ {
@@ -450,6 +778,13 @@ func BenchmarkBobKeyGenPrvP751(b *testing.B) {
 	}
 }
 
+func BenchmarkBobKeyGenPrvP610(b *testing.B) {
+	prv := NewPrivateKey(Fp610, KeyVariantSidhB)
+	for n := 0; n < b.N; n++ {
+		prv.Generate(rand.Reader)
+	}
+}
+
 func BenchmarkBobKeyGenPrvP503(b *testing.B) {
 	prv := NewPrivateKey(Fp503, KeyVariantSidhB)
 	for n := 0; n < b.N; n++ {
@@ -473,6 +808,15 @@ func BenchmarkAliceKeyGenPubP751(b *testing.B) {
 	}
 }
 
+func BenchmarkAliceKeyGenPubP610(b *testing.B) {
+	prv := NewPrivateKey(Fp610, KeyVariantSidhA)
+	pub := NewPublicKey(Fp610, KeyVariantSidhA)
+	prv.Generate(rand.Reader)
+	for n := 0; n < b.N; n++ {
+		prv.GeneratePublicKey(pub)
+	}
+}
+
 func BenchmarkAliceKeyGenPubP503(b *testing.B) {
 	prv := NewPrivateKey(Fp503, KeyVariantSidhA)
 	pub := NewPublicKey(Fp503, KeyVariantSidhA)
@@ -500,6 +844,15 @@ func BenchmarkBobKeyGenPubP751(b *testing.B) {
 	}
 }
 
+func BenchmarkBobKeyGenPubP610(b *testing.B) {
+	prv := NewPrivateKey(Fp610, KeyVariantSidhB)
+	pub := NewPublicKey(Fp610, KeyVariantSidhB)
+	prv.Generate(rand.Reader)
+	for n := 0; n < b.N; n++ {
+		prv.GeneratePublicKey(pub)
+	}
+}
+
 func BenchmarkBobKeyGenPubP503(b *testing.B) {
 	prv := NewPrivateKey(Fp503, KeyVariantSidhB)
 	pub := NewPublicKey(Fp503, KeyVariantSidhB)
@@ -527,6 +880,15 @@ func BenchmarkSharedSecretAliceP751(b *testing.B) {
 	}
 }
 
+func BenchmarkSharedSecretAliceP610(b *testing.B) {
+	aPr := convToPrv(tdataSidh[Fp610].PrA, KeyVariantSidhA, Fp610)
+	bPk := convToPub(tdataSidh[Fp610].PkB, KeyVariantSidhB, Fp610)
+	var ss [2 * 77]byte
+	for n := 0; n < b.N; n++ {
+		aPr.DeriveSecret(ss[:], bPk)
+	}
+}
+
 func BenchmarkSharedSecretAliceP503(b *testing.B) {
 	aPr := convToPrv(tdataSidh[Fp503].PrA, KeyVariantSidhA, Fp503)
 	bPk := convToPub(tdataSidh[Fp503].PkB, KeyVariantSidhB, Fp503)
@@ -555,6 +917,16 @@ func BenchmarkSharedSecretBobP751(b *testing.B) {
 	}
 }
 
+func BenchmarkSharedSecretBobP610(b *testing.B) {
+	// m_B = 3*randint(0,3^238)
+	aPk := convToPub(tdataSidh[Fp610].PkA, KeyVariantSidhA, Fp610)
+	bPr := convToPrv(tdataSidh[Fp610].PrB, KeyVariantSidhB, Fp610)
+	var ss [2 * 77]byte
+	for n := 0; n < b.N; n++ {
+		bPr.DeriveSecret(ss[:], aPk)
+	}
+}
+
 func BenchmarkSharedSecretBobP503(b *testing.B) {
 	// m_B = 3*randint(0,3^238)
 	aPk := convToPub(tdataSidh[Fp503].PkA, KeyVariantSidhA, Fp503)
@@ -1,6 +1,7 @@
 package sidh
 
 import (
+	"crypto/rand"
 	"crypto/subtle"
 	"errors"
 	"io"
@@ -9,14 +10,25 @@ import (
 	"github.com/cloudflare/circl/internal/shake"
 )
 
+var (
+	// ErrKeyType is returned when a key of wrong variant or for a different
+	// field is provided.
+	ErrKeyType = errors.New("sidh: wrong type of key")
+	// ErrCiphertextSize is returned when ciphertext has wrong length.
+	ErrCiphertextSize = errors.New("sidh: wrong size of ciphertext")
+	// ErrSeedSize is returned when seed has wrong length.
+	ErrSeedSize = errors.New("sidh: wrong size of seed")
+	// ErrUnsupportedID is returned when field identifier is not supported.
+	ErrUnsupportedID = errors.New("sidh: unsupported field id")
+)
+
 // SIKE KEM interface
 type KEM struct {
-	allocated   bool
-	rng         io.Reader
-	msg         []byte
-	secretBytes []byte
-	params      *common.SidhParams
-	shake       shake.Shake
+	allocated bool
+	rng       io.Reader
+	msg       []byte
+	params    *common.SidhParams
+	shake     shake.Shake
 }
 
 // NewSike434 instantiates SIKE/p434 KEM
@@ -33,6 +45,13 @@ func NewSike503(rng io.Reader) *KEM {
 	return &c
 }
 
+// NewSike610 instantiates SIKE/p610 KEM
+func NewSike610(rng io.Reader) *KEM {
+	var c KEM
+	c.Allocate(Fp610, rng)
+	return &c
+}
+
 // NewSike751 instantiates SIKE/p751 KEM
 func NewSike751(rng io.Reader) *KEM {
 	var c KEM
@@ -46,7 +65,6 @@ func (c *KEM) Allocate(id uint8, rng io.Reader) {
 	c.rng = rng
 	c.params = common.Params(id)
 	c.msg = make([]byte, c.params.MsgLen)
-	c.secretBytes = make([]byte, c.params.A.SecretByteLen)
 	c.shake = shake.NewShake256()
 	c.allocated = true
 }
@@ -78,30 +96,7 @@ func (c *KEM) Encapsulate(ciphertext, secret []byte, pub *PublicKey) error {
 		return err
 	}
 
-	var buf [3 * common.MaxSharedSecretBsz]byte
-	var skA = PrivateKey{
-		key: key{
-			params:     c.params,
-			keyVariant: KeyVariantSidhA},
-		Scalar: c.secretBytes}
-	var pkA = NewPublicKey(c.params.ID, KeyVariantSidhA)
-
-	pub.Export(buf[:])
-	c.shake.Reset()
-	c.shake.Write(c.msg)
-	c.shake.Write(buf[:3*c.params.SharedSecretSize])
-	c.shake.Read(skA.Scalar)
-
-	// Ensure bitlength is not bigger then to 2^e2-1
-	skA.Scalar[len(skA.Scalar)-1] &= (1 << (c.params.A.SecretBitLen % 8)) - 1
-	skA.GeneratePublicKey(pkA)
-	c.generateCiphertext(ciphertext, &skA, pkA, pub, c.msg[:])
-
-	// K = H(msg||(c0||c1))
-	c.shake.Reset()
-	c.shake.Write(c.msg)
-	c.shake.Write(ciphertext)
-	c.shake.Read(secret[:c.SharedSecretSize()])
+	encapsulate(&c.shake, c.params, ciphertext, secret, c.msg, pub)
 	return nil
 }
 
@@ -130,43 +125,7 @@ func (c *KEM) Decapsulate(secret []byte, prv *PrivateKey, pub *PublicKey, cipher
 		panic("ciphertext buffer to small")
 	}
 
-	var m [common.MaxMsgBsz]byte
-	var r [common.MaxSidhPrivateKeyBsz]byte
-	var pkBytes [3 * common.MaxSharedSecretBsz]byte
-	var skA = PrivateKey{
-		key: key{
-			params:     c.params,
-			keyVariant: KeyVariantSidhA},
-		Scalar: c.secretBytes}
-	var pkA = NewPublicKey(c.params.ID, KeyVariantSidhA)
-	c1Len := c.decrypt(m[:], prv, ciphertext)
-
-	// r' = G(m'||pub)
-	pub.Export(pkBytes[:])
-	c.shake.Reset()
-	c.shake.Write(m[:c1Len])
-	c.shake.Write(pkBytes[:3*c.params.SharedSecretSize])
-	c.shake.Read(r[:c.params.A.SecretByteLen])
-	// Ensure bitlength is not bigger than 2^e2-1
-	r[c.params.A.SecretByteLen-1] &= (1 << (c.params.A.SecretBitLen % 8)) - 1
-
-	// Never fails
-	skA.Import(r[:c.params.A.SecretByteLen])
-	skA.GeneratePublicKey(pkA)
-	pkA.Export(pkBytes[:])
-
-	// S is chosen at random when generating a key and unknown to other party. It is
-	// important that S is unpredictable to the other party.  Without this check, would
-	// be possible to recover a secret, by providing series of invalid ciphertexts.
-	//
-	// See more details in "On the security of supersingular isogeny cryptosystems"
-	// (S. Galbraith, et al., 2016, ePrint #859).
-	mask := subtle.ConstantTimeCompare(pkBytes[:c.params.PublicKeySize], ciphertext[:pub.params.PublicKeySize])
-	common.Cpick(mask, m[:c1Len], m[:c1Len], prv.S)
-	c.shake.Reset()
-	c.shake.Write(m[:c1Len])
-	c.shake.Write(ciphertext)
-	c.shake.Read(secret[:c.SharedSecretSize()])
+	decapsulate(&c.shake, c.params, secret, prv, pub, ciphertext)
 	return nil
 }
 
@@ -177,10 +136,6 @@ func (c *KEM) Reset() {
 	for i := range c.msg {
 		c.msg[i] = 0
 	}
-
-	for i := range c.secretBytes {
-		c.secretBytes[i] = 0
-	}
 }
 
 // Returns size of resulting ciphertext
@@ -193,23 +148,6 @@ func (c *KEM) SharedSecretSize() int {
 	return c.params.KemSize
 }
 
-func (c *KEM) generateCiphertext(ctext []byte, skA *PrivateKey, pkA, pkB *PublicKey, ptext []byte) {
-	var n [common.MaxMsgBsz]byte
-	var j [common.MaxSharedSecretBsz]byte
-	var ptextLen = skA.params.MsgLen
-
-	skA.DeriveSecret(j[:], pkB)
-	c.shake.Reset()
-	c.shake.Write(j[:skA.params.SharedSecretSize])
-	c.shake.Read(n[:ptextLen])
-	for i := range ptext {
-		n[i] ^= ptext[i]
-	}
-
-	pkA.Export(ctext)
-	copy(ctext[pkA.Size():], n[:ptextLen])
-}
-
 // encrypt uses SIKE public key to encrypt plaintext. Requires cryptographically secure
 // PRNG. Returns ciphertext in case encryption succeeds. Returns error in case PRNG fails
 // or wrongly formated input was provided.
@@ -228,7 +166,7 @@ func (c *KEM) encrypt(ctext []byte, rng io.Reader, pub *PublicKey, ptext []byte)
 	}
 
 	skA.GeneratePublicKey(pkA)
-	c.generateCiphertext(ctext, skA, pkA, pub, ptext)
+	generateCiphertext(&c.shake, ctext, skA, pkA, pub, ptext)
 	return nil
 }
 
@@ -236,6 +174,249 @@ func (c *KEM) encrypt(ctext []byte, rng io.Reader, pub *PublicKey, ptext []byte)
 // decryption succeeds or error in case unexptected input was provided.
 // Constant time
 func (c *KEM) decrypt(n []byte, prv *PrivateKey, ctext []byte) int {
+	return decrypt(&c.shake, n, prv, ctext)
+}
+
+// Scheme implements SIKE KEM for a single field. Contrary to KEM it doesn't
+// keep any mutable state, all temporary buffers are allocated per call. Hence,
+// a Scheme may be used by multiple goroutines concurrently, as long as its
+// random source is safe for concurrent use.
+type Scheme struct {
+	params *common.SidhParams
+	rng    io.Reader
+}
+
+// NewScheme returns SIKE KEM for the field identified by id. The rng must be
+// cryptographically secure PRNG. If rng is nil, crypto/rand.Reader is used.
+// Returns ErrUnsupportedID in case id is not supported.
+func NewScheme(id uint8, rng io.Reader) (*Scheme, error) {
+	switch id {
+	case Fp434, Fp503, Fp610, Fp751:
+	default:
+		return nil, ErrUnsupportedID
+	}
+	if rng == nil {
+		rng = rand.Reader
+	}
+	return &Scheme{params: common.Params(id), rng: rng}, nil
+}
+
+// GenerateKeyPair generates a new SIKE key pair. Error is returned in case
+// PRNG fails.
+func (s *Scheme) GenerateKeyPair() (*PublicKey, *PrivateKey, error) {
+	prv := NewPrivateKey(s.params.ID, KeyVariantSike)
+	pub := NewPublicKey(s.params.ID, KeyVariantSike)
+	if err := prv.Generate(s.rng); err != nil {
+		return nil, nil, err
+	}
+	prv.GeneratePublicKey(pub)
+	return pub, prv, nil
+}
+
+// GenerateKeyPairFromSeed deterministically derives SIKE key pair from the
+// seed of KeySeedSize() bytes. The seed is a concatenation of the value S
+// and the secret scalar, exactly as read from randombytes() by the
+// crypto_kem_keypair() function of the NIST reference implementation.
+// Same seed always yields the same key pair, hence it must be kept secret.
+// Error is returned in case seed has wrong size.
+func (s *Scheme) GenerateKeyPairFromSeed(seed []byte) (*PublicKey, *PrivateKey, error) {
+	if len(seed) != s.KeySeedSize() {
+		return nil, nil, ErrSeedSize
+	}
+
+	prv := NewPrivateKey(s.params.ID, KeyVariantSike)
+	pub := NewPublicKey(s.params.ID, KeyVariantSike)
+	copy(prv.S, seed[:s.params.MsgLen])
+	copy(prv.Scalar, seed[s.params.MsgLen:])
+	// Number of bits of the key-space which fall into the last byte. Contrary
+	// to Generate, the most significant bit is not forced to be set.
+	lastBits := s.params.B.SecretBitLen - 8*(s.params.B.SecretByteLen-1)
+	prv.Scalar[len(prv.Scalar)-1] &= (1 << lastBits) - 1
+	prv.GeneratePublicKey(pub)
+	return pub, prv, nil
+}
+
+// Encapsulate receives the public key and returns SIKE ciphertext and shared
+// secret. Error is returned in case PRNG fails or the public key doesn't
+// match the scheme.
+func (s *Scheme) Encapsulate(pub *PublicKey) (ct, ss []byte, err error) {
+	var msg [common.MaxMsgBsz]byte
+
+	// Generate ephemeral value
+	if _, err = io.ReadFull(s.rng, msg[:s.params.MsgLen]); err != nil {
+		return nil, nil, err
+	}
+	return s.EncapsulateDeterministically(pub, msg[:s.params.MsgLen])
+}
+
+// EncapsulateDeterministically works as Encapsulate, but uses the seed of
+// EncapsulationSeedSize() bytes as the ephemeral value instead of reading it
+// from PRNG. Seed must be kept secret and never reused, otherwise the shared
+// secret can be recovered. Error is returned in case seed has wrong size or
+// the public key doesn't match the scheme.
+func (s *Scheme) EncapsulateDeterministically(pub *PublicKey, seed []byte) (ct, ss []byte, err error) {
+	var h = shake.NewShake256()
+
+	if pub == nil || !s.isSikeKey(&pub.key) {
+		return nil, nil, ErrKeyType
+	}
+
+	if len(seed) != s.EncapsulationSeedSize() {
+		return nil, nil, ErrSeedSize
+	}
+
+	ct = make([]byte, s.params.CiphertextSize)
+	ss = make([]byte, s.params.KemSize)
+	encapsulate(&h, s.params, ct, ss, seed, pub)
+	return ct, ss, nil
+}
+
+// Decapsulate given the keypair and ciphertext as inputs, outputs a shared
+// secret if plaintext verifies correctly, otherwise function outputs random
+// value. Error is returned in case keys don't match the scheme or ciphertext
+// has wrong size.
+func (s *Scheme) Decapsulate(prv *PrivateKey, pub *PublicKey, ct []byte) ([]byte, error) {
+	var h = shake.NewShake256()
+
+	if prv == nil || pub == nil || !s.isSikeKey(&prv.key) || !s.isSikeKey(&pub.key) {
+		return nil, ErrKeyType
+	}
+
+	if len(ct) != s.params.CiphertextSize {
+		return nil, ErrCiphertextSize
+	}
+
+	ss := make([]byte, s.params.KemSize)
+	decapsulate(&h, s.params, ss, prv, pub, ct)
+	return ss, nil
+}
+
+// Returns size of resulting ciphertext
+func (s *Scheme) CiphertextSize() int {
+	return s.params.CiphertextSize
+}
+
+// Returns size of resulting shared secret
+func (s *Scheme) SharedSecretSize() int {
+	return s.params.KemSize
+}
+
+// Returns size of the public key
+func (s *Scheme) PublicKeySize() int {
+	return s.params.PublicKeySize
+}
+
+// Returns size of the private key
+func (s *Scheme) PrivateKeySize() int {
+	return int(s.params.B.SecretByteLen) + s.params.MsgLen
+}
+
+// Returns size of the seed used by GenerateKeyPairFromSeed
+func (s *Scheme) KeySeedSize() int {
+	return s.params.MsgLen + int(s.params.B.SecretByteLen)
+}
+
+// Returns size of the seed used by EncapsulateDeterministically
+func (s *Scheme) EncapsulationSeedSize() int {
+	return s.params.MsgLen
+}
+
+// isSikeKey returns true if k is a SIKE key for the field used by the scheme.
+func (s *Scheme) isSikeKey(k *key) bool {
+	return k.params != nil && k.keyVariant == KeyVariantSike && k.params.ID == s.params.ID
+}
+
+// encapsulate computes SIKE ciphertext and shared secret from the ephemeral
+// value msg and the public key. The h is used as a scratch hash state.
+func encapsulate(h *shake.Shake, params *common.SidhParams, ciphertext, secret, msg []byte, pub *PublicKey) {
+	var buf [3 * common.MaxSharedSecretBsz]byte
+	var r [common.MaxSidhPrivateKeyBsz]byte
+	var skA = PrivateKey{
+		key: key{
+			params:     params,
+			keyVariant: KeyVariantSidhA},
+		Scalar: r[:params.A.SecretByteLen]}
+	var pkA = NewPublicKey(params.ID, KeyVariantSidhA)
+
+	pub.Export(buf[:])
+	h.Reset()
+	h.Write(msg)
+	h.Write(buf[:3*params.SharedSecretSize])
+	h.Read(skA.Scalar)
+
+	// Ensure bitlength is not bigger then to 2^e2-1
+	skA.Scalar[len(skA.Scalar)-1] &= (1 << (params.A.SecretBitLen % 8)) - 1
+	skA.GeneratePublicKey(pkA)
+	generateCiphertext(h, ciphertext, &skA, pkA, pub, msg)
+
+	// K = H(msg||(c0||c1))
+	h.Reset()
+	h.Write(msg)
+	h.Write(ciphertext[:params.CiphertextSize])
+	h.Read(secret[:params.KemSize])
+}
+
+// decapsulate computes SIKE shared secret from the ciphertext and key pair.
+// The h is used as a scratch hash state.
+func decapsulate(h *shake.Shake, params *common.SidhParams, secret []byte, prv *PrivateKey, pub *PublicKey, ciphertext []byte) {
+	var m [common.MaxMsgBsz]byte
+	var r [common.MaxSidhPrivateKeyBsz]byte
+	var pkBytes [3 * common.MaxSharedSecretBsz]byte
+	var skA = PrivateKey{
+		key: key{
+			params:     params,
+			keyVariant: KeyVariantSidhA},
+		Scalar: r[:params.A.SecretByteLen]}
+	var pkA = NewPublicKey(params.ID, KeyVariantSidhA)
+	c1Len := decrypt(h, m[:], prv, ciphertext)
+
+	// r' = G(m'||pub)
+	pub.Export(pkBytes[:])
+	h.Reset()
+	h.Write(m[:c1Len])
+	h.Write(pkBytes[:3*params.SharedSecretSize])
+	h.Read(skA.Scalar)
+	// Ensure bitlength is not bigger than 2^e2-1
+	skA.Scalar[len(skA.Scalar)-1] &= (1 << (params.A.SecretBitLen % 8)) - 1
+
+	skA.GeneratePublicKey(pkA)
+	pkA.Export(pkBytes[:])
+
+	// S is chosen at random when generating a key and unknown to other party. It is
+	// important that S is unpredictable to the other party.  Without this check, would
+	// be possible to recover a secret, by providing series of invalid ciphertexts.
+	//
+	// See more details in "On the security of supersingular isogeny cryptosystems"
+	// (S. Galbraith, et al., 2016, ePrint #859).
+	mask := subtle.ConstantTimeCompare(pkBytes[:params.PublicKeySize], ciphertext[:pub.params.PublicKeySize])
+	common.Cpick(mask, m[:c1Len], m[:c1Len], prv.S)
+	h.Reset()
+	h.Write(m[:c1Len])
+	h.Write(ciphertext)
+	h.Read(secret[:params.KemSize])
+}
+
+func generateCiphertext(h *shake.Shake, ctext []byte, skA *PrivateKey, pkA, pkB *PublicKey, ptext []byte) {
+	var n [common.MaxMsgBsz]byte
+	var j [common.MaxSharedSecretBsz]byte
+	var ptextLen = skA.params.MsgLen
+
+	skA.DeriveSecret(j[:], pkB)
+	h.Reset()
+	h.Write(j[:skA.params.SharedSecretSize])
+	h.Read(n[:ptextLen])
+	for i := range ptext {
+		n[i] ^= ptext[i]
+	}
+
+	pkA.Export(ctext)
+	copy(ctext[pkA.Size():], n[:ptextLen])
+}
+
+// decrypt uses SIKE private key to decrypt ciphertext. Returns length of
+// the plaintext stored in n. The h is used as a scratch hash state.
+// Constant time
+func decrypt(h *shake.Shake, n []byte, prv *PrivateKey, ctext []byte) int {
 	var c1Len int
 	var j [common.MaxSharedSecretBsz]byte
 	var pkLen = prv.params.PublicKeySize
@@ -248,9 +429,9 @@ func (c *KEM) decrypt(n []byte, prv *PrivateKey, ctext []byte) int {
 	// Never fails
 	c0.Import(ctext[:pkLen])
 	prv.DeriveSecret(j[:], c0)
-	c.shake.Reset()
-	c.shake.Write(j[:prv.params.SharedSecretSize])
-	c.shake.Read(n[:c1Len])
+	h.Reset()
+	h.Write(j[:prv.params.SharedSecretSize])
+	h.Read(n[:c1Len])
 	for i := range n[:c1Len] {
 		n[i] ^= ctext[pkLen+i]
 	}
@@ -9,6 +9,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/cloudflare/circl/dh/sidh/internal/common"
@@ -58,6 +59,28 @@ var tdataSike = map[uint8]sikeVec{
 			"A9C4724D414B35AF69D6ECB21BFDA23BFF6B66C22C2451DC8E1C",
 		"7BF6938C975658AEB8B4D37CFFBDE25D97E561F36C219A0E8FE645816DBBC7ED7B57" +
 			"7700AE8DC3138E97A0C3F6F002065C92A0B1B8180208"},
+	// Unlike the other entries, the P-610 KAT file is not the official one,
+	// see the header of PQCkemKAT_524.rsp.
+	Fp610: {
+		Fp610, "P-610", NewSike610(rand.Reader),
+		"testdata/PQCkemKAT_524.rsp",
+		"9C8E8A3272C3FB87014544E1A0E202E11B891BEFD4208F268F76A9B43622CC20" +
+			"FAD8FFDBFEDC075C1D38F76E3881C4007CF96BAC54F7346BE17F2E2A03540A26" +
+			"D6A7CA10FCD670D3E283CA4E02CB070DBD95C961D2FADF3619908101C051CBFF" +
+			"DDBA32AE756DA93B06BB09DBF06CB9A417665036792A4CC241BF72BA7551FDA0" +
+			"ABCD9E9CFDB32D8229A8D94B8EF1755FF8FEC4F76FB513F39401EAC4D3833E06" +
+			"63BB83266BAB38A8FD7E4113E166F2CAD7C33F7C1C28AD30BF387C381B489A45" +
+			"7B1BDEF438E081136A9DD493D84573CA0CE2EA843DEEC2A638FA2578B2072FF2" +
+			"FB71536A93CA005FA75A2718AD646AF6AEE31215752734667AB8AB5B988AC4A6" +
+			"0D56BBC937E888E60FEC587A5B5455A68E361929F969F168DC03C7A6F66766A0" +
+			"9DDD106BF3D96CBFC83674030FD3AD4EA6BA6601B0A20A4C19414433A4FFB36E" +
+			"582C1293B66BBE805F5CD22683DD256279D43CDC47B75B7B4BE5A0B3D44E0D58" +
+			"E9BF22A85AB938FB52FA79EF6596914E5A50C39BCEC5B6085104C889747F9294" +
+			"01493E4A8F4EEEE435F7B6D12AC92C23CEDFE5A67C337823589177C9E2A24DE7" +
+			"1144FB8A51A9099EADA00ED36FEF7E69F11464BC2D4AA8442CA817490AC65F76" +
+			"7D11B16B994407092773F2609F00",
+		"D60B93492A1D8C1C7BA6FC0B733137F3406CEE8110A93F17003271531CF27285" +
+			"B8721ED5CB46853043B346A66CBA6CF765F1B0EAA40BF6723F327918FF97"},
 	Fp751: {
 		Fp751, "P-751", NewSike751(rand.Reader),
 		"testdata/PQCkemKAT_644.rsp",
@@ -373,6 +396,39 @@ func testKAT(t *testing.T, v sikeVec) {
 		return bytes.Equal(pubKeyBytes, pk)
 	}
 
+	// Checks that KAT can be reproduced from the seed. NIST reference
+	// implementation calls randombytes() to get S, secret scalar and then
+	// ephemeral value, in that order.
+	testDeterministic := func(seed, pk, sk, ct, ss []byte) {
+		var entropy [48]byte
+		s, err := NewScheme(v.id, nil)
+		CheckNoErr(t, err, "scheme creation failed")
+		var msgLen = v.kem.params.MsgLen
+		var prvBytes = make([]byte, s.PrivateKeySize())
+		var pubBytes = make([]byte, s.PublicKeySize())
+
+		copy(entropy[:], seed)
+		drbg := NewDRBG(&entropy)
+		keySeed := make([]byte, s.KeySeedSize())
+		_, _ = drbg.Read(keySeed[:msgLen])
+		_, _ = drbg.Read(keySeed[msgLen:])
+		pub, prv, err := s.GenerateKeyPairFromSeed(keySeed)
+		CheckNoErr(t, err, "key generation from seed failed")
+		pub.Export(pubBytes)
+		prv.Export(prvBytes)
+		if !bytes.Equal(pubBytes, pk) || !bytes.Equal(prvBytes, sk) {
+			t.Fatalf("KAT key generation from seed failed\n")
+		}
+
+		encSeed := make([]byte, s.EncapsulationSeedSize())
+		_, _ = drbg.Read(encSeed)
+		ctGot, ssGot, err := s.EncapsulateDeterministically(pub, encSeed)
+		CheckNoErr(t, err, "deterministic encapsulation failed")
+		if !bytes.Equal(ctGot, ct) || !bytes.Equal(ssGot, ss) {
+			t.Fatalf("KAT deterministic encapsulation failed\n")
+		}
+	}
+
 	f, err := os.Open(v.KatFile)
 	if err != nil {
 		t.Fatal(err)
@@ -395,7 +451,7 @@ func testKAT(t *testing.T, v sikeVec) {
 		// count
 		_ = strings.Split(string(line), "=")[1]
 		// seed
-		_ = readAndCheckLine(r)
+		seed := readAndCheckLine(r)
 		// pk
 		pk := readAndCheckLine(r)
 		// sk (secret key in test vector is concatenation of
@@ -407,12 +463,152 @@ func testKAT(t *testing.T, v sikeVec) {
 		// ss
 		ss := readAndCheckLine(r)
 
+		testDeterministic(seed, pk, sk, ct, ss)
 		testKeygen(pk, sk)
 		testDecapsulation(pk, sk, ct, ss)
 		testKEMRoundTrip(t, pk, sk, v)
 	}
 }
 
+func testSchemeRoundTrip(t *testing.T, v sikeVec) {
+	s, err := NewScheme(v.id, rand.Reader)
+	CheckNoErr(t, err, "scheme creation failed")
+	pk, sk, err := s.GenerateKeyPair()
+	CheckNoErr(t, err, "key generation failed")
+
+	ct, ssE, err := s.Encapsulate(pk)
+	CheckNoErr(t, err, "encapsulation failed")
+	if len(ct) != s.CiphertextSize() || len(ssE) != s.SharedSecretSize() {
+		t.Fatalf("wrong output size [%s]", v.name)
+	}
+
+	ssD, err := s.Decapsulate(sk, pk, ct)
+	CheckNoErr(t, err, "decapsulation failed")
+	if !bytes.Equal(ssE, ssD) {
+		t.Errorf("Shared secrets from decapsulation and encapsulation differ [%s]", v.name)
+	}
+
+	// Scheme must be interoperable with KEM
+	var ssK [common.MaxSharedSecretBsz]byte
+	v.kem.Reset()
+	err = v.kem.Decapsulate(ssK[:s.SharedSecretSize()], sk, pk, ct)
+	CheckNoErr(t, err, "decapsulation failed")
+	if !bytes.Equal(ssE, ssK[:s.SharedSecretSize()]) {
+		t.Errorf("Shared secrets from Scheme and KEM differ [%s]", v.name)
+	}
+}
+
+func testNegativeScheme(t *testing.T, v sikeVec) {
+	s, err := NewScheme(v.id, rand.Reader)
+	CheckNoErr(t, err, "scheme creation failed")
+	pk, sk, err := s.GenerateKeyPair()
+	CheckNoErr(t, err, "key generation failed")
+	ct, ssE, err := s.Encapsulate(pk)
+	CheckNoErr(t, err, "pre-requisite for a test failed")
+
+	_, err = s.Decapsulate(sk, pk, ct[:len(ct)-1])
+	if err != ErrCiphertextSize {
+		ReportError(t, err, ErrCiphertextSize, "too small ciphertext")
+	}
+	_, err = s.Decapsulate(sk, pk, append(ct, 0))
+	if err != ErrCiphertextSize {
+		ReportError(t, err, ErrCiphertextSize, "too big ciphertext")
+	}
+
+	// Keys of wrong variant, for other field or missing
+	pkSidh := NewPublicKey(v.id, KeyVariantSidhB)
+	prSidh := NewPrivateKey(v.id, KeyVariantSidhB)
+	other := Fp503
+	if v.id == Fp503 {
+		other = Fp434
+	}
+	pkOther := NewPublicKey(other, KeyVariantSike)
+	for _, p := range []*PublicKey{nil, pkSidh, pkOther, {}} {
+		_, _, err = s.Encapsulate(p)
+		if err != ErrKeyType {
+			ReportError(t, err, ErrKeyType, "encapsulation with wrong key")
+		}
+		_, err = s.Decapsulate(sk, p, ct)
+		if err != ErrKeyType {
+			ReportError(t, err, ErrKeyType, "decapsulation with wrong public key")
+		}
+	}
+	for _, p := range []*PrivateKey{nil, prSidh, {}} {
+		_, err = s.Decapsulate(p, pk, ct)
+		if err != ErrKeyType {
+			ReportError(t, err, ErrKeyType, "decapsulation with wrong private key")
+		}
+	}
+
+	// Change ciphertext
+	ct[0] = ct[0] - 1
+	ssD, err := s.Decapsulate(sk, pk, ct)
+	CheckNoErr(t, err, "decapsulation returns error when invalid ciphertext provided")
+	if bytes.Equal(ssE, ssD) {
+		t.Error("critical error")
+	}
+
+	// Seeds of wrong size
+	_, _, err = s.GenerateKeyPairFromSeed(make([]byte, s.KeySeedSize()-1))
+	if err != ErrSeedSize {
+		ReportError(t, err, ErrSeedSize, "key generation with too small seed")
+	}
+	_, _, err = s.EncapsulateDeterministically(pk, make([]byte, s.EncapsulationSeedSize()+1))
+	if err != ErrSeedSize {
+		ReportError(t, err, ErrSeedSize, "encapsulation with too big seed")
+	}
+
+	// Unsupported field
+	_, err = NewScheme(0xFF, rand.Reader)
+	if err != ErrUnsupportedID {
+		ReportError(t, err, ErrUnsupportedID, "unsupported id")
+	}
+
+	// PRNG failure is reported
+	s, err = NewScheme(v.id, bytes.NewReader(nil))
+	CheckNoErr(t, err, "scheme creation failed")
+	_, _, err = s.Encapsulate(pk)
+	CheckIsErr(t, err, "encapsulation must fail when PRNG fails")
+	_, _, err = s.GenerateKeyPair()
+	CheckIsErr(t, err, "key generation must fail when PRNG fails")
+}
+
+// Scheme must be safe for concurrent use. Run with -race.
+func testSchemeConcurrent(t *testing.T, v sikeVec) {
+	const numGoroutines = 8
+	s, err := NewScheme(v.id, nil)
+	CheckNoErr(t, err, "scheme creation failed")
+	pk, sk, err := s.GenerateKeyPair()
+	CheckNoErr(t, err, "key generation failed")
+
+	var wg sync.WaitGroup
+	errs := make(chan error, numGoroutines)
+	for i := 0; i < numGoroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ct, ssE, err := s.Encapsulate(pk)
+			if err != nil {
+				errs <- err
+				return
+			}
+			ssD, err := s.Decapsulate(sk, pk, ct)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if !bytes.Equal(ssE, ssD) {
+				errs <- fmt.Errorf("shared secrets differ [%s]", v.name)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
 // Interface to "testing"
 
 /* -------------------------------------------------------------------------
@@ -430,6 +626,9 @@ func TestNegativePKE(t *testing.T)      { testSike(t, &tdataSike, testNegativePK
 func TestKEMKeyGeneration(t *testing.T) { testSike(t, &tdataSike, testKEMKeyGeneration) }
 func TestNegativeKEM(t *testing.T)      { testSike(t, &tdataSike, testNegativeKEM) }
 func TestKAT(t *testing.T)              { testSike(t, &tdataSike, testKAT) }
+func TestSchemeRoundTrip(t *testing.T)  { testSike(t, &tdataSike, testSchemeRoundTrip) }
+func TestNegativeScheme(t *testing.T)   { testSike(t, &tdataSike, testNegativeScheme) }
+func TestSchemeConcurrent(t *testing.T) { testSike(t, &tdataSike, testSchemeConcurrent) }
 func TestNegativeKEMSameWrongResult(t *testing.T) {
 	testSike(t, &tdataSike, testNegativeKEMSameWrongResult)
 }
@@ -554,3 +753,18 @@ func ExampleKEM() {
 	// true
 	// true
 }
+
+func ExampleScheme() {
+	// Scheme may be shared between goroutines
+	s, _ := NewScheme(Fp503, rand.Reader)
+	// Bob's key pair
+	pubB, prvB, _ := s.GenerateKeyPair()
+	// Allice performs encapsulation with Bob's public key
+	ct, ssE, _ := s.Encapsulate(pubB)
+	// Bob performs decapsulation with his key pair
+	ssD, _ := s.Decapsulate(prvB, pubB, ct)
+	fmt.Printf("%t\n", bytes.Equal(ssE, ssD))
+
+	// Output:
+	// true
+}
@@ -10,11 +10,19 @@ points [3,4]. To do that, the Shared function performs this validation
 internally and returns false when the public key is invalid (i.e., it
 is a low-order point).
 
+Conversion from Ed25519 keys.
+
+Ed25519 keys can be reused as X25519 keys. The PublicKeyFromEd25519 and
+SecretKeyFromEd25519 functions convert keys of the sign/ed25519 package
+in the same way as libsodium does [5]. The public key conversion rejects
+points of small order.
+
 References:
  - [1] RFC7748 by Langley, Hamburg, Turner (https://rfc-editor.org/rfc/rfc7748.txt)
  - [2] Curve25519 by Bernstein (https://cr.yp.to/ecdh.html)
  - [3] Bernstein (https://cr.yp.to/ecdh.html#validate)
  - [4] Cremers&Jackson (https://eprint.iacr.org/2019/526)
+ - [5] libsodium (https://doc.libsodium.org/advanced/ed25519-curve25519)
 
 */
 package x25519
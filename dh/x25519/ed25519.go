@@ -0,0 +1,53 @@
+package x25519
+
+import (
+	"crypto/sha512"
+
+	"github.com/cloudflare/circl/group/edwards25519"
+	fp "github.com/cloudflare/circl/math/fp25519"
+	"github.com/cloudflare/circl/sign/ed25519"
+)
+
+// PublicKeyFromEd25519 sets public to the X25519 public key that
+// corresponds to the Ed25519 public key pub, using the birational map
+// u = (1+y)/(1-y) from edwards25519 to Curve25519. It returns false if pub
+// is not the canonical encoding of a point, or if the point has small
+// order; in that case, public is not modified.
+func PublicKeyFromEd25519(public *Key, pub ed25519.PublicKey) bool {
+	if len(pub) != ed25519.Size {
+		return false
+	}
+	var enc [edwards25519.Size]byte
+	var P, Q edwards25519.Point
+	copy(enc[:], pub)
+	if !P.Unmarshal(&enc) {
+		return false
+	}
+	Q.MulByCofactor(&P)
+	if Q.IsIdentity() {
+		return false
+	}
+	_, y, z, _ := P.ExtendedCoordinates()
+	num, den := &fp.Elt{}, &fp.Elt{}
+	fp.Add(num, &z, &y)
+	fp.Sub(den, &z, &y)
+	fp.Inv(den, den)
+	fp.Mul(num, num, den) // u = (z+y)/(z-y)
+	fp.ToBytes(public[:], num)
+	return true
+}
+
+// SecretKeyFromEd25519 sets secret to the X25519 secret key that
+// corresponds to the Ed25519 private key priv, which is the clamped first
+// half of the SHA-512 hash of priv. Hence, KeyGen calculates from secret
+// the same public key as PublicKeyFromEd25519 does from the Ed25519 public
+// key. It panics if priv does not have ed25519.Size bytes.
+func SecretKeyFromEd25519(secret *Key, priv ed25519.PrivateKey) {
+	if len(priv) != ed25519.Size {
+		panic("x25519: bad private key length")
+	}
+	var k Key
+	h := sha512.Sum512(priv)
+	copy(k[:], h[:Size])
+	secret.clamp(&k)
+}
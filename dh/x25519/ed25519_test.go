@@ -0,0 +1,87 @@
+package x25519
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/cloudflare/circl/internal/test"
+	"github.com/cloudflare/circl/sign/ed25519"
+)
+
+func TestEd25519Conversion(t *testing.T) {
+	const testTimes = 1 << 7
+
+	t.Run("shared", func(t *testing.T) {
+		var secA, secB, pubA, pubB, genA, sharedAB, sharedBA Key
+		for i := 0; i < testTimes; i++ {
+			alice, err := ed25519.GenerateKey(rand.Reader)
+			test.CheckNoErr(t, err, "key generation failed")
+			bob, err := ed25519.GenerateKey(rand.Reader)
+			test.CheckNoErr(t, err, "key generation failed")
+
+			SecretKeyFromEd25519(&secA, alice.GetPrivate())
+			SecretKeyFromEd25519(&secB, bob.GetPrivate())
+			okA := PublicKeyFromEd25519(&pubA, alice.GetPublic())
+			okB := PublicKeyFromEd25519(&pubB, bob.GetPublic())
+			if !okA || !okB {
+				test.ReportError(t, okA && okB, true, alice, bob)
+			}
+
+			KeyGen(&genA, &secA)
+			if genA != pubA {
+				test.ReportError(t, genA, pubA, alice)
+			}
+
+			okA = Shared(&sharedAB, &secA, &pubB)
+			okB = Shared(&sharedBA, &secB, &pubA)
+			if !okA || !okB || sharedAB != sharedBA {
+				test.ReportError(t, sharedAB, sharedBA, alice, bob)
+			}
+		}
+	})
+
+	t.Run("libsodium", func(t *testing.T) {
+		// Test vector from the ed25519_convert test of libsodium.
+		seed, _ := hex.DecodeString("421151a459faeade3d247115f94aedae42318124095afabe4d1451a559faedee")
+		var wantPub, wantSec, gotPub, gotSec Key
+		hexStr2Key(&wantPub, "f1814f0e8ff1043d8a44d25babff3cedcae6c22c3edaa48f857ae70de2baae50")
+		hexStr2Key(&wantSec, "8052030376d47112be7f73ed7a019293dd12ad910b654455798b4667d73de166")
+
+		keys := ed25519.NewKeyFromSeed(seed)
+		SecretKeyFromEd25519(&gotSec, keys.GetPrivate())
+		if gotSec != wantSec {
+			test.ReportError(t, gotSec, wantSec)
+		}
+		if !PublicKeyFromEd25519(&gotPub, keys.GetPublic()) || gotPub != wantPub {
+			test.ReportError(t, gotPub, wantPub)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		invalid := []string{
+			// Points of small order.
+			"0100000000000000000000000000000000000000000000000000000000000000",
+			"ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"0000000000000000000000000000000000000000000000000000000000000080",
+			"c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a",
+			"c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac03fa",
+			"26e8958fc2b227b045c3f489f2ef98f0d5dfac05d3c63339b13802886d53fc05",
+			"26e8958fc2b227b045c3f489f2ef98f0d5dfac05d3c63339b13802886d53fc85",
+			// Non-canonical encoding of y = 1.
+			"eeffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+			// Not a point on the curve.
+			"0200000000000000000000000000000000000000000000000000000000000000",
+			// Wrong length.
+			"0900",
+		}
+		for i, v := range invalid {
+			pub, _ := hex.DecodeString(v)
+			var got Key
+			if PublicKeyFromEd25519(&got, pub) {
+				test.ReportError(t, true, false, i, v)
+			}
+		}
+	})
+}
@@ -1,5 +1,3 @@
-// +build amd64,go1.12
-
 package fourq
 
 import (
@@ -56,6 +54,27 @@ func (P *Point) IsIdentity() bool {
 	return _P.IsIdentity()
 }
 
+// IsEqual returns true if P and Q represent the same point.
+func (P *Point) IsEqual(Q *Point) bool { return P.X.IsEqual(&Q.X) && P.Y.IsEqual(&Q.Y) }
+
+// Neg calculates P = -Q.
+func (P *Point) Neg(Q *Point) { fqNeg(&P.X, &Q.X); P.Y = Q.Y }
+
+// Double calculates P = 2Q.
+func (P *Point) Double(Q *Point) {
+	var _Q pointR1
+	Q.toR1(&_Q)
+	_Q.double()
+	P.fromR1(&_Q)
+}
+
+// Cmov sets P = Q if b is not zero, otherwise P remains unchanged. It runs
+// in constant time.
+func (P *Point) Cmov(Q *Point, b int) {
+	fqCmov(&P.X, &Q.X, b)
+	fqCmov(&P.Y, &Q.Y, b)
+}
+
 // Add calculates a point addition P = Q + R
 func (P *Point) Add(Q, R *Point) {
 	var _Q, _R pointR1
@@ -67,6 +86,13 @@ func (P *Point) Add(Q, R *Point) {
 	P.fromR1(&_Q)
 }
 
+// Sub calculates a point subtraction P = Q - R
+func (P *Point) Sub(Q, R *Point) {
+	var _R Point
+	_R.Neg(R)
+	P.Add(Q, &_R)
+}
+
 // ScalarMult calculates P = k*Q, where Q is an N-torsion point.
 func (P *Point) ScalarMult(k *[Size]byte, Q *Point) {
 	var _P, _Q pointR1
@@ -83,6 +109,22 @@ func (P *Point) ScalarBaseMult(k *[Size]byte) {
 	P.fromR1(&_P)
 }
 
+// VarTimeDoubleScalarBaseMult calculates P = a*Q + b*G, where G is the
+// generator point. Q must be an N-torsion point: if Q is not on the curve or
+// has a component of order dividing the cofactor, it returns false and P
+// remains unchanged. This function is not constant-time, hence it must be
+// used only with public inputs.
+func (P *Point) VarTimeDoubleScalarBaseMult(a *[Size]byte, Q *Point, b *[Size]byte) bool {
+	var _P, _Q pointR1
+	Q.toR1(&_Q)
+	if !_Q.IsOnCurve() || !_Q.isTorsionN() {
+		return false
+	}
+	_P.doubleScalarMult(a, &_Q, b)
+	P.fromR1(&_P)
+	return true
+}
+
 func (P *Point) fromR1(Q *pointR1) {
 	Q.ToAffine()
 	P.X = Q.X
@@ -1,9 +1,8 @@
-// +build amd64,go1.12
-
 package fourq
 
 import (
 	"crypto/rand"
+	"math/big"
 	"testing"
 
 	"github.com/cloudflare/circl/internal/conv"
@@ -73,6 +72,210 @@ func TestMarshal(t *testing.T) {
 	})
 }
 
+func TestUnmarshal(t *testing.T) {
+	const testTimes = 1 << 10
+	var buf, in [Size]byte
+	var P Point
+	p := getModulus()
+	for i := 0; i < testTimes; i++ {
+		_, _ = rand.Read(buf[:])
+		buf[15] &= 0x7F
+		in = buf
+
+		// x^2 = u/v has a solution iff the norm of u*v is a square in Fp.
+		var y, u, v, one Fq
+		enc := buf
+		enc[Size-1] &= 0x7F
+		_ = y.fromBytes(enc[:])
+		one.setOne()
+		fqSqr(&u, &y)
+		fqMul(&v, &u, &paramD)
+		fqSub(&u, &u, &one)
+		fqAdd(&v, &v, &one)
+		fqMul(&u, &u, &v)
+		u0, u1 := u.toBigInt()
+		norm := new(big.Int).Mul(u0, u0)
+		norm.Add(norm, u1.Mul(u1, u1)).Mod(norm, p)
+
+		got := P.Unmarshal(&in)
+		want := big.Jacobi(norm, p) >= 0
+		if got != want {
+			test.ReportError(t, got, want, buf)
+		}
+		if got && !P.IsOnCurve() {
+			test.ReportError(t, P.IsOnCurve(), true, buf)
+		}
+		if in != buf {
+			test.ReportError(t, in, buf, "input was modified")
+		}
+	}
+}
+
+func TestPointOps(t *testing.T) {
+	const testTimes = 1 << 8
+	var P, Q, R, S Point
+
+	t.Run("P-P=0", func(t *testing.T) {
+		for i := 0; i < testTimes; i++ {
+			P.random()
+			Q.Sub(&P, &P)
+			got := Q.IsIdentity()
+			want := true
+			if got != want {
+				test.ReportError(t, got, want, P)
+			}
+		}
+	})
+	t.Run("P+(-P)=0", func(t *testing.T) {
+		for i := 0; i < testTimes; i++ {
+			P.random()
+			Q.Neg(&P)
+			Q.Add(&P, &Q)
+			got := Q.IsIdentity()
+			want := true
+			if got != want {
+				test.ReportError(t, got, want, P)
+			}
+		}
+	})
+	t.Run("2P=P+P", func(t *testing.T) {
+		for i := 0; i < testTimes; i++ {
+			P.random()
+			Q.Double(&P)
+			R.Add(&P, &P)
+			got := Q.IsEqual(&R)
+			want := true
+			if got != want {
+				test.ReportError(t, got, want, P)
+			}
+		}
+	})
+	t.Run("(P+Q)-Q=P", func(t *testing.T) {
+		for i := 0; i < testTimes; i++ {
+			P.random()
+			Q.random()
+			R.Add(&P, &Q)
+			R.Sub(&R, &Q)
+			got := R.IsEqual(&P)
+			want := true
+			if got != want {
+				test.ReportError(t, got, want, P, Q)
+			}
+			got = R.IsEqual(&Q)
+			want = false
+			if got != want {
+				test.ReportError(t, got, want, P, Q)
+			}
+		}
+	})
+	t.Run("cmov", func(t *testing.T) {
+		for i := 0; i < testTimes; i++ {
+			P.random()
+			Q.random()
+			R = P
+			R.Cmov(&Q, 0)
+			S = P
+			S.Cmov(&Q, 1)
+			if !R.IsEqual(&P) {
+				test.ReportError(t, R, P, Q)
+			}
+			if !S.IsEqual(&Q) {
+				test.ReportError(t, S, Q, P)
+			}
+		}
+	})
+	t.Run("doubleMult", func(t *testing.T) {
+		var a, b [Size]byte
+		for i := 0; i < testTimes; i++ {
+			P.random()
+			_, _ = rand.Read(a[:])
+			_, _ = rand.Read(b[:])
+			if ok := Q.VarTimeDoubleScalarBaseMult(&a, &P, &b); !ok {
+				test.ReportError(t, ok, true, P)
+			}
+			var _P, _R pointR1
+			P.toR1(&_P)
+			_R.ScalarMult(&a, &_P)
+			R.fromR1(&_R)
+			S.ScalarBaseMult(&b)
+			R.Add(&R, &S)
+			got := Q.IsEqual(&R)
+			want := true
+			if got != want {
+				test.ReportError(t, got, want, P, a, b)
+			}
+		}
+	})
+}
+
+func TestTorsion(t *testing.T) {
+	const testTimes = 1 << 7
+	var a, b, buf [Size]byte
+	var P, Q, R, T2 Point
+	// T2 = (0,-1) is the point of order 2.
+	T2.X.setZero()
+	T2.Y.setOne()
+	fqNeg(&T2.Y, &T2.Y)
+
+	t.Run("doubleMult", func(t *testing.T) {
+		for i := 0; i < testTimes; i++ {
+			P.random()
+			_, _ = rand.Read(a[:])
+			_, _ = rand.Read(b[:])
+			Q.Add(&P, &T2)
+			R = P
+			if ok := R.VarTimeDoubleScalarBaseMult(&a, &Q, &b); ok {
+				test.ReportError(t, ok, false, Q)
+			}
+			if !R.IsEqual(&P) {
+				test.ReportError(t, R, P, Q)
+			}
+			if ok := R.VarTimeDoubleScalarBaseMult(&a, &T2, &b); ok {
+				test.ReportError(t, ok, false, T2)
+			}
+			Q.X[0][0] ^= 1
+			if ok := R.VarTimeDoubleScalarBaseMult(&a, &Q, &b); ok {
+				test.ReportError(t, ok, false, Q)
+			}
+		}
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var _Q pointR1
+		valid, invalid := 0, 0
+		for valid == 0 || invalid == 0 {
+			P.random()
+			_, _ = rand.Read(buf[:])
+			buf[15] &= 0x7F
+			buf[31] &= 0x7F
+			R = P
+			if !R.Unmarshal(&buf) {
+				// On failure, the point must not be modified.
+				if !R.IsEqual(&P) {
+					test.ReportError(t, R, P, buf)
+				}
+				invalid++
+				continue
+			}
+			// A random point on the curve is not N-torsion, except with
+			// probability 1/392, but clearing the cofactor makes it so.
+			R.toR1(&_Q)
+			if _Q.isTorsionN() {
+				continue
+			}
+			if ok := Q.VarTimeDoubleScalarBaseMult(&a, &R, &b); ok {
+				test.ReportError(t, ok, false, R)
+			}
+			_Q.ClearCofactor()
+			R.fromR1(&_Q)
+			if ok := Q.VarTimeDoubleScalarBaseMult(&a, &R, &b); !ok {
+				test.ReportError(t, ok, true, R)
+			}
+			valid++
+		}
+	})
+}
+
 func BenchmarkCurve(b *testing.B) {
 	var P, Q, R Point
 	var k [32]byte
@@ -92,7 +295,7 @@ func BenchmarkCurve(b *testing.B) {
 
 	b.Run("Double", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
-			P.Add(&Q, &Q)
+			P.Double(&Q)
 		}
 	})
 
@@ -107,4 +310,10 @@ func BenchmarkCurve(b *testing.B) {
 			P.ScalarMult(&k, &Q)
 		}
 	})
+
+	b.Run("VarTimeDoubleScalarBaseMult", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.VarTimeDoubleScalarBaseMult(&k, &Q, &k)
+		}
+	})
 }
@@ -1,8 +1,11 @@
 // Package fourq provides elliptic curve operations over FourQ curve.
 //
 // FourQ is a high-speed elliptic curve at the 128-bit security level. This package
-// contains an AMD64-optimized implementation. In particular, this package does
-// not implement FourQ's endomorphisms or lattice reduction techniques.
+// contains an AMD64-optimized implementation, and a portable Go implementation
+// that is used on other architectures or when building with the noasm tag.
+// Variable-base scalar multiplication uses the four-dimensional decomposition
+// given by FourQ's endomorphisms, whereas fixed-base scalar multiplication
+// uses a precomputed table and the modified LSB-set comb method.
 //
 //
 // References:
@@ -0,0 +1,257 @@
+package fourq
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// The endomorphisms φ and ψ of FourQ are computed on a curve Ê, which is
+// 2-isogenous to E, the short Weierstrass model of FourQ. Let τ: E → Ê be
+// this isogeny and τ' its dual, then
+//
+//	φ = τ' ∘ φÊ ∘ τ and ψ = τ' ∘ ψÊ ∘ τ,
+//
+// where φÊ is an endomorphism of degree 10 of Ê (a 5-isogeny followed by a
+// 2-isogeny), and ψÊ is a 2-isogeny followed by the Frobenius map. The map
+// χ = τ' ∘ ψÊ ∘ φÊ ∘ τ is used as the third endomorphism. On the subgroup of
+// order N, these endomorphisms act as multiplication by the scalars λφ, λψ,
+// and λχ = λφλψ/2, respectively.
+//
+// Reference: Section 3 of https://eprint.iacr.org/2015/565
+
+// pointW is a point (x/xz, y/yz) of a curve in short Weierstrass form.
+type pointW struct{ x, xz, y, yz Fq }
+
+// fromR1 maps a point from FourQ to E, using the map (x,y) ↦ (u/B+A/(3B),
+// v/B), where u = (1+y)/(1-y) and v = u/x are the coordinates on the
+// Montgomery curve By^2=x^3+Ax^2+x.
+func (P *pointW) fromR1(Q *pointR1) {
+	var s, t Fq
+	fqAdd(&t, &Q.Z, &Q.Y)
+	fqSub(&s, &Q.Z, &Q.Y)
+	fqMul(&P.yz, &s, &Q.X)
+	fqMul(&P.yz, &P.yz, &montB) // yz = B(Z-Y)X
+	fqMul(&P.y, &t, &Q.Z)       // y = (Z+Y)Z
+	fqMul(&P.xz, &s, &mont3B)   // xz = 3B(Z-Y)
+	fqMul(&s, &s, &montA)
+	fqAdd(&P.x, &t, &t)
+	fqAdd(&P.x, &P.x, &t)
+	fqAdd(&P.x, &P.x, &s) // x = 3(Z+Y)+A(Z-Y)
+}
+
+// fromW maps a point from E to FourQ. It is the inverse of pointW.fromR1.
+func (P *pointR1) fromW(Q *pointW) {
+	var u, t Fq
+	fqMul(&u, &Q.x, &mont3B)
+	fqMul(&t, &Q.xz, &montA)
+	fqSub(&u, &u, &t) // u = 3Bx-Axz
+	fqAdd(&t, &Q.xz, &Q.xz)
+	fqAdd(&t, &t, &Q.xz)    // t = 3xz
+	fqMul(&P.Ta, &u, &Q.yz) // Ta = u*yz
+	fqMul(&P.Z, &Q.xz, &Q.y)
+	fqMul(&P.Z, &P.Z, &mont3B) // Z = 3B*xz*y
+	fqSub(&P.Tb, &u, &t)       // Tb = u-3xz
+	fqAdd(&u, &u, &t)          // u = u+3xz
+	fqMul(&P.X, &P.Ta, &u)
+	fqMul(&P.Y, &P.Tb, &P.Z)
+	fqMul(&P.Z, &P.Z, &u)
+}
+
+// frobenius applies the Frobenius map (x,y) ↦ (x^p,y^p) to P.
+func (P *pointW) frobenius() {
+	fpNeg(&P.x[1], &P.x[1])
+	fpNeg(&P.xz[1], &P.xz[1])
+	fpNeg(&P.y[1], &P.y[1])
+	fpNeg(&P.yz[1], &P.yz[1])
+}
+
+// isogeny2 is a 2-isogeny with kernel (x0,0), followed by the isomorphism
+// (x,y) ↦ (u2*x,u3*y), and then by the Frobenius map if frob is set. The
+// value v is 3x0^2+a, where a is the coefficient of x in the domain curve.
+type isogeny2 struct {
+	x0, v, u2, u3 Fq
+	frob          bool
+}
+
+// eval calculates P = f(P), where the isogeny is given by the map
+// (x,y) ↦ (x+v/(x-x0), y(1-v/(x-x0)^2)).
+func (f *isogeny2) eval(P *pointW) {
+	var t, t2, vz Fq
+	fqMul(&t, &f.x0, &P.xz)
+	fqSub(&t, &P.x, &t) // t = x-x0*xz
+	fqSqr(&vz, &P.xz)
+	fqMul(&vz, &vz, &f.v) // vz = v*xz^2
+	fqSqr(&t2, &t)
+	fqMul(&P.x, &P.x, &t)
+	fqAdd(&P.x, &P.x, &vz)
+	fqMul(&P.x, &P.x, &f.u2)
+	fqMul(&P.xz, &P.xz, &t)
+	fqSub(&t, &t2, &vz)
+	fqMul(&P.y, &P.y, &t)
+	fqMul(&P.y, &P.y, &f.u3)
+	fqMul(&P.yz, &P.yz, &t2)
+	if f.frob {
+		P.frobenius()
+	}
+}
+
+// isogeny5 calculates P = f(P), where f is the 5-isogeny of φÊ given by the
+// map (x,y) ↦ (n(x)/h(x)^2, y*m(x)/h(x)^3), and h is its kernel polynomial.
+func (P *pointW) isogeny5() {
+	var zp [len(isoPhi5M)]Fq
+	var h, h2, t Fq
+	zp[0].setOne()
+	for i := 1; i < len(zp); i++ {
+		fqMul(&zp[i], &zp[i-1], &P.xz)
+	}
+	hornerHom(&h, isoPhi5H[:], &P.x, zp[:])
+	hornerHom(&t, isoPhi5M[:], &P.x, zp[:])
+	fqMul(&P.y, &P.y, &t)
+	hornerHom(&t, isoPhi5N[:], &P.x, zp[:])
+	P.x = t
+	fqSqr(&h2, &h)
+	fqMul(&P.xz, &P.xz, &h2)
+	fqMul(&h2, &h2, &h)
+	fqMul(&P.yz, &P.yz, &h2)
+}
+
+// hornerHom calculates z = c[n]x^n + c[n-1]x^(n-1)y + ... + c[0]y^n, where
+// n = len(c)-1, and yp[i] = y^i.
+func hornerHom(z *Fq, c []Fq, x *Fq, yp []Fq) {
+	var t Fq
+	n := len(c) - 1
+	*z = c[n]
+	for i := n - 1; i >= 0; i-- {
+		fqMul(z, z, x)
+		fqMul(&t, &c[i], &yp[n-i])
+		fqAdd(z, z, &t)
+	}
+}
+
+// endoMultiples calculates T[i] = P + i0*φ(P) + i1*ψ(P) + i2*χ(P), where
+// i = i0 + 2*i1 + 4*i2, and P is an N-torsion point other than the identity.
+func (P *pointR1) endoMultiples(T *[8]pointR2) {
+	var W, phiW, psiW, chiW pointW
+	var S [8]pointR1
+	var endoP [3]pointR2
+	W.fromR1(P)
+	isoTau.eval(&W)
+	phiW = W
+	phiW.isogeny5()
+	isoPhi2.eval(&phiW)
+	psiW = W
+	isoPsi.eval(&psiW)
+	chiW = phiW
+	isoPsi.eval(&chiW)
+	for i, Wi := range []*pointW{&phiW, &psiW, &chiW} {
+		isoTauDual.eval(Wi)
+		S[0].fromW(Wi)
+		endoP[i].FromR1(&S[0])
+	}
+
+	S[0].copy(P)
+	T[0].FromR1(P)
+	for j := range endoP {
+		for i := 0; i < 1<<uint(j); i++ {
+			k := i + 1<<uint(j)
+			S[k].copy(&S[i])
+			S[k].add(&endoP[j])
+			T[k].FromR1(&S[k])
+		}
+	}
+}
+
+// decompose calculates a = (a0,a1,a2,a3) such that
+//
+//	k = a0 + a1*λφ + a2*λψ + a3*λχ (mod N),
+//
+// where a0 is odd, and all ai are 64-bit integers. It calculates
+// (k,0,0,0) - Σ αi*bi + c, where the bi are the vectors of a reduced basis
+// of the lattice of decompositions of zero, αi = floor(k*ℓi/2^256) are
+// approximations of the coordinates of (k,0,0,0) in this basis, and c is
+// a lattice vector that makes all the ai positive. If a0 is even, the basis
+// vector b1, whose first coordinate is odd, is also added. Runs in constant
+// time.
+func decompose(a *[4]uint64, k *[Size]byte) {
+	var m [4]uint64
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(k[8*i : 8*i+8])
+	}
+	*a = latticeOffset
+	a[0] += m[0]
+	for i := range latticeEll {
+		alpha := mulWord4(&m, &latticeEll[i])
+		for j := range a {
+			a[j] -= alpha * uint64(latticeBasis[i][j])
+		}
+	}
+	mask := (a[0] & 1) - 1
+	for j := range a {
+		a[j] += mask & uint64(latticeBasis[1][j])
+	}
+}
+
+// mulWord4 returns floor(x*y/2^256) mod 2^64.
+func mulWord4(x, y *[4]uint64) uint64 {
+	var z [8]uint64
+	for i := range x {
+		var carry uint64
+		for j := range y {
+			hi, lo := bits.Mul64(x[i], y[j])
+			lo, c := bits.Add64(lo, z[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			z[i+j] = lo
+			carry = hi
+		}
+		z[i+4] = carry
+	}
+	return z[4]
+}
+
+// recodeMultiScalar recodes a, as returned by decompose, into signed digits
+// such that a[j] = Σ s[i]*b[j][i]*2^i, for 0 ≤ i ≤ 64, where s[i] = ±1,
+// b[0][i] = 1, and b[j][i] is 0 or 1. Each digit d[i] = s[i]*(u+1) encodes
+// the index u = b[1][i] + 2*b[2][i] + 4*b[3][i] to the table calculated by
+// endoMultiples. Since a[0] is odd, s[i] = 2*bit(a[0],i+1)-1 for i < 64
+// and s[64] = 1. Runs in constant time.
+func recodeMultiScalar(d *[65]int8, a *[4]uint64) {
+	a1, a2, a3 := a[1], a[2], a[3]
+	for i := 0; i < 64; i++ {
+		neg := ((a[0] >> uint(i+1)) & 1) ^ 1
+		b1 := a1 & 1
+		b2 := a2 & 1
+		b3 := a3 & 1
+		a1 = (a1 >> 1) + (b1 & neg)
+		a2 = (a2 >> 1) + (b2 & neg)
+		a3 = (a3 >> 1) + (b3 & neg)
+		sig := -int8(neg)
+		d[i] = ((int8(b1|b2<<1|b3<<2) + 1) ^ sig) - sig
+	}
+	d[64] = int8(a1|a2<<1|a3<<2) + 1
+}
+
+var (
+	// latticeBasis is a reduced basis of the lattice of vectors (a0,a1,a2,a3)
+	// such that a0 + a1*λφ + a2*λψ + a3*λχ = 0 (mod N).
+	latticeBasis = [4][4]int64{
+		{0x1165196ae20d6b8a, 0x140d0299a0a1bac1, 0x0f9083ac39bd9f5d, 0x023e3f76b3720db2},
+		{0x15e898fed25dbc55, -0x0d30cbcc7a45de83, 0x16d2e3de2685a26c, -0x0773b4baada3091e},
+		{0x16f45c47328b5595, -0x025fb7dfbf77c0da, -0x1db0dad4ef3c8c04, 0x194277dd9ad2b62d},
+		{0x1fa6e8fca3920b58, 0x07a6c96d10d91c87, -0x1991c47c22fd54b0, -0x3155f3e02717129a},
+	}
+	// latticeEll has the values ℓi = floor(2^256*ci), where (1,0,0,0) =
+	// Σ ci*bi for the vectors bi of latticeBasis.
+	latticeEll = [4][4]uint64{
+		{0xab09e7516b4c212e, 0x4519945f1b9bedb6, 0xa3dda3bf1ecf2d30, 0x0000000000000002},
+		{0x1c15f09e4d6731c7, 0xf86558a897c2d46e, 0x206075223247c266, 0x0000000000000004},
+		{0xef39c98579f9a0c0, 0x9bf159df7fc69678, 0x7672802fa5eeb38d, 0x0000000000000003},
+		{0x59fdd14ccd069d77, 0x6e322fea608af90e, 0x45063762143e4670, 0x0000000000000001},
+	}
+	// latticeOffset is a lattice vector that makes positive the output of
+	// decompose.
+	latticeOffset = [4]uint64{
+		0x0ef92067b2184d3f, 0x4aecf8d931258f56, 0x7cd704fc9a6f8edd, 0xb87b90cf91523275,
+	}
+)
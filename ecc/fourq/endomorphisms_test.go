@@ -0,0 +1,136 @@
+package fourq
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/cloudflare/circl/internal/conv"
+	"github.com/cloudflare/circl/internal/test"
+)
+
+// Eigenvalues of the endomorphisms φ, ψ, and χ on the N-torsion subgroup.
+var (
+	lambdaPhi, _ = new(big.Int).SetString("d712b593ceecb9c4e66deea5e9f1bf5449d8a6e318a56683c3b4abf8ffe91", 16)
+	lambdaPsi, _ = new(big.Int).SetString("c623a9a9a8c934626128855a9da8f659b446504f787442ee3f95be6fe9544", 16)
+	lambdaChi, _ = new(big.Int).SetString("6d9069dc876c883c18f03c13d737624874f0b5e4daa9bcdf0e63ce8997864", 16)
+)
+
+func TestEndomorphisms(t *testing.T) {
+	const testTimes = 1 << 7
+	var P, got, want pointR1
+	var T [8]pointR2
+	var k [Size]byte
+	order := conv.Uint64Le2BigInt(orderGenerator[:])
+	lambda := []*big.Int{lambdaPhi, lambdaPsi, lambdaChi}
+
+	t.Run("eigenvalues", func(t *testing.T) {
+		// φ^2 = [-40] and ψφ = [2]χ.
+		l := new(big.Int)
+		l.Mul(lambdaPhi, lambdaPhi).Add(l, big.NewInt(40)).Mod(l, order)
+		if l.Sign() != 0 {
+			test.ReportError(t, l, 0, "λφ^2 != -40")
+		}
+		l.Mul(lambdaPhi, lambdaPsi).Sub(l, lambdaChi).Sub(l, lambdaChi).Mod(l, order)
+		if l.Sign() != 0 {
+			test.ReportError(t, l, 0, "λφλψ != 2λχ")
+		}
+	})
+
+	t.Run("table", func(t *testing.T) {
+		for i := 0; i < testTimes; i++ {
+			P.random()
+			P.endoMultiples(&T)
+			for j := range T {
+				// want = (1 + j0*λφ + j1*λψ + j2*λχ)*P
+				s := big.NewInt(1)
+				for b := range lambda {
+					if (j>>uint(b))&1 == 1 {
+						s.Add(s, lambda[b])
+					}
+				}
+				s.Mod(s, order)
+				conv.BigInt2BytesLe(k[:], s)
+				want.scalarMultWindowed(&k, &P)
+
+				got.SetIdentity()
+				got.add(&T[j])
+				if !got.isEqual(&want) {
+					test.ReportError(t, got, want, P, j)
+				}
+			}
+		}
+	})
+}
+
+func TestDecompose(t *testing.T) {
+	const testTimes = 1 << 12
+	var k [Size]byte
+	var a [4]uint64
+	order := conv.Uint64Le2BigInt(orderGenerator[:])
+	two256 := new(big.Int).Lsh(big.NewInt(1), 256)
+	edge := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		new(big.Int).Sub(order, big.NewInt(1)),
+		order,
+		new(big.Int).Sub(two256, big.NewInt(1)),
+		new(big.Int).Sub(two256, order),
+	}
+	got, ai := new(big.Int), new(big.Int)
+	for i := 0; i < testTimes; i++ {
+		var want *big.Int
+		if i < len(edge) {
+			want = edge[i]
+		} else {
+			want, _ = rand.Int(rand.Reader, two256)
+		}
+		conv.BigInt2BytesLe(k[:], want)
+		decompose(&a, &k)
+		if a[0]&1 != 1 {
+			test.ReportError(t, a[0]&1, 1, want)
+		}
+
+		got.SetUint64(a[0])
+		for j, lj := range []*big.Int{lambdaPhi, lambdaPsi, lambdaChi} {
+			ai.SetUint64(a[j+1])
+			got.Add(got, ai.Mul(ai, lj))
+		}
+		got.Sub(got, want).Mod(got, order)
+		if got.Sign() != 0 {
+			test.ReportError(t, a, want, k)
+		}
+	}
+}
+
+func TestRecodeMultiScalar(t *testing.T) {
+	const testTimes = 1 << 12
+	var k [Size]byte
+	var a, got [4]uint64
+	var d [65]int8
+	for i := 0; i < testTimes; i++ {
+		_, _ = rand.Read(k[:])
+		decompose(&a, &k)
+		recodeMultiScalar(&d, &a)
+
+		got = [4]uint64{}
+		for j := len(d) - 1; j >= 0; j-- {
+			mask := d[j] >> 7
+			u := ((d[j] + mask) ^ mask) - 1
+			if u < 0 || u > 7 {
+				test.ReportError(t, d[j], "a digit in [-8,8]\\{0}", k)
+			}
+			s := uint64(1) - uint64(mask&1)<<1
+			for l := range got {
+				b := uint64(1)
+				if l > 0 {
+					b = uint64(u>>uint(l-1)) & 1
+				}
+				got[l] = got[l]<<1 + s*b
+			}
+		}
+		if got != a {
+			test.ReportError(t, got, a, k)
+		}
+	}
+}
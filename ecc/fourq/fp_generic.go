@@ -0,0 +1,107 @@
+package fourq
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+func (f *Fp) toWords() (f0, f1 uint64) {
+	return binary.LittleEndian.Uint64(f[0:8]), binary.LittleEndian.Uint64(f[8:16])
+}
+
+func (f *Fp) fromWords(f0, f1 uint64) {
+	binary.LittleEndian.PutUint64(f[0:8], f0)
+	binary.LittleEndian.PutUint64(f[8:16], f1)
+}
+
+// fpReduce calculates c2:c1:c0 mod p, given that c2 < 2^62, using that
+// 2^127 = 1 mod p. The output is less than 2^127, so it is reduced except
+// when it is equal to p.
+func fpReduce(c0, c1, c2 uint64) (uint64, uint64) {
+	var carry uint64
+	for i := 0; i < 2; i++ {
+		c0, carry = bits.Add64(c0, (c2<<1)|(c1>>63), 0)
+		c1 = (c1 & (1<<63 - 1)) + carry
+		c2 = 0
+	}
+	return c0, c1
+}
+
+// fpModGeneric sets c to its canonical representative in [0, p).
+func fpModGeneric(c *Fp) {
+	c0, c1 := c.toWords()
+	c0, c1 = fpReduce(c0, c1, 0)
+	// Now, c = p is the only value that is not reduced.
+	t0, carry := bits.Add64(c0, 1, 0)
+	t1 := c1 + carry
+	mask := -(t1 >> 63)
+	c0 = (c0 &^ mask) | (t0 & mask)
+	c1 = (c1 &^ mask) | (t1 & mask & (1<<63 - 1))
+	c.fromWords(c0, c1)
+}
+
+// fpAddGeneric calculates c = a + b mod p, given that a and b are less than
+// 2^128.
+func fpAddGeneric(c, a, b *Fp) {
+	a0, a1 := a.toWords()
+	b0, b1 := b.toWords()
+	c0, carry := bits.Add64(a0, b0, 0)
+	c1, carry := bits.Add64(a1, b1, carry)
+	c.fromWords(fpReduce(c0, c1, carry))
+}
+
+// fpSubGeneric calculates c = a - b mod p, given that a and b are less than
+// 2^127.
+func fpSubGeneric(c, a, b *Fp) {
+	a0, a1 := a.toWords()
+	b0, b1 := b.toWords()
+	c0, borrow := bits.Sub64(a0, b0, 0)
+	c1, _ := bits.Sub64(a1, b1, borrow)
+	// If a < b, then 2^128 + a - b is reduced to p + a - b.
+	borrow = c1 >> 63
+	c1 &= 1<<63 - 1
+	c0, borrow = bits.Sub64(c0, borrow, 0)
+	c1, _ = bits.Sub64(c1, 0, borrow)
+	c.fromWords(c0, c1)
+}
+
+// fpMulGeneric calculates c = a * b mod p, given that a and b are less than
+// 2^127.
+func fpMulGeneric(c, a, b *Fp) {
+	a0, a1 := a.toWords()
+	b0, b1 := b.toWords()
+
+	// (h3:l3)*2^128 + (h1:l1 + h2:l2)*2^64 + h0:l0
+	h0, l0 := bits.Mul64(a0, b0)
+	h1, l1 := bits.Mul64(a0, b1)
+	h2, l2 := bits.Mul64(a1, b0)
+	h3, l3 := bits.Mul64(a1, b1)
+
+	var r1, r2, r3, c0, c1, carry uint64
+	r1, carry = bits.Add64(h0, l1, 0)
+	r2, carry = bits.Add64(h1, l3, carry)
+	r3, _ = bits.Add64(h3, 0, carry)
+	r1, carry = bits.Add64(r1, l2, 0)
+	r2, carry = bits.Add64(r2, h2, carry)
+	r3, _ = bits.Add64(r3, 0, carry)
+
+	// Since 2^128 = 2 mod p, then r = (r1:l0) + 2*(r3:r2) mod p.
+	// As the product is less than 2^254, 2*(r3:r2) is less than 2^127.
+	r3 = (r3 << 1) | (r2 >> 63)
+	r2 <<= 1
+	c0, carry = bits.Add64(l0, r2, 0)
+	c1, carry = bits.Add64(r1, r3, carry)
+	c.fromWords(fpReduce(c0, c1, carry))
+}
+
+// fpSqrGeneric calculates c = a^2 mod p.
+func fpSqrGeneric(c, a *Fp) { fpMulGeneric(c, a, a) }
+
+// fpHlfGeneric calculates c = a/2 mod p, given that a is less than 2^127.
+// Since 1/2 = 2^126 mod p, this is a right rotation of the 127-bit word a.
+func fpHlfGeneric(c, a *Fp) {
+	a0, a1 := a.toWords()
+	c0 := (a0 >> 1) | (a1 << 63)
+	c1 := ((a1 & (1<<63 - 1)) >> 1) | ((a0 & 0x1) << 62)
+	c.fromWords(c0, c1)
+}
@@ -1,5 +1,3 @@
-// +build amd64
-
 package fourq
 
 import (
@@ -18,6 +16,44 @@ func (e *Fq) setZero()                    { var z Fp; e[0] = z; e[1] = z }
 func (e *Fq) setOne()                     { e.setZero(); e[0][0] = 1 }
 func (e *Fq) isZero() bool                { return e[0].isZero() && e[1].isZero() }
 
+// SetZero assigns e = 0.
+func (e *Fq) SetZero() { e.setZero() }
+
+// SetOne assigns e = 1.
+func (e *Fq) SetOne() { e.setOne() }
+
+// IsZero returns true if e = 0.
+func (e *Fq) IsZero() bool { return e.isZero() }
+
+// IsEqual returns true if e and f represent the same element.
+func (e *Fq) IsEqual(f *Fq) bool {
+	var t Fq
+	fqSub(&t, e, f)
+	return t.isZero()
+}
+
+// FqAdd calculates c = a + b.
+func FqAdd(c, a, b *Fq) { fqAdd(c, a, b) }
+
+// FqSub calculates c = a - b.
+func FqSub(c, a, b *Fq) { fqSub(c, a, b) }
+
+// FqMul calculates c = a * b.
+func FqMul(c, a, b *Fq) { fqMul(c, a, b) }
+
+// FqSqr calculates c = a^2.
+func FqSqr(c, a *Fq) { fqSqr(c, a) }
+
+// FqNeg calculates c = -a.
+func FqNeg(c, a *Fq) { fqNeg(c, a) }
+
+// FqInv calculates c = 1/a, or c = 0 if a = 0.
+func FqInv(c, a *Fq) { fqInv(c, a) }
+
+// FqCmov sets c = a if b is not zero, otherwise c remains unchanged. It runs
+// in constant time.
+func FqCmov(c, a *Fq, b int) { fqCmov(c, a, b) }
+
 func (e *Fq) toBytes(buf []byte) {
 	if len(buf) == 2*SizeFp {
 		e[0].toBytes(buf[:SizeFp])
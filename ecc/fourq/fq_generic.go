@@ -0,0 +1,43 @@
+package fourq
+
+// fqCmovGeneric sets c to a if b is not zero, in constant time.
+func fqCmovGeneric(c, a *Fq, b int) {
+	v := byte(b | -b)
+	mask := -(v >> 7)
+	for i := range c {
+		for j := range c[i] {
+			c[i][j] = (c[i][j] &^ mask) | (a[i][j] & mask)
+		}
+	}
+}
+
+func fqAddGeneric(c, a, b *Fq) {
+	fpAddGeneric(&c[0], &a[0], &b[0])
+	fpAddGeneric(&c[1], &a[1], &b[1])
+}
+
+func fqSubGeneric(c, a, b *Fq) {
+	fpSubGeneric(&c[0], &a[0], &b[0])
+	fpSubGeneric(&c[1], &a[1], &b[1])
+}
+
+// fqMulGeneric calculates c = a*b = (a0*b0 - a1*b1) + (a0*b1 + a1*b0)i.
+func fqMulGeneric(c, a, b *Fq) {
+	var t0, t1, t2, t3 Fp
+	fpMulGeneric(&t0, &a[0], &b[0])
+	fpMulGeneric(&t1, &a[1], &b[1])
+	fpMulGeneric(&t2, &a[0], &b[1])
+	fpMulGeneric(&t3, &a[1], &b[0])
+	fpSubGeneric(&c[0], &t0, &t1)
+	fpAddGeneric(&c[1], &t2, &t3)
+}
+
+// fqSqrGeneric calculates c = a^2 = (a0 + a1)(a0 - a1) + 2*a0*a1i.
+func fqSqrGeneric(c, a *Fq) {
+	var t0, t1, t2 Fp
+	fpAddGeneric(&t0, &a[0], &a[1])
+	fpSubGeneric(&t1, &a[0], &a[1])
+	fpAddGeneric(&t2, &a[0], &a[0])
+	fpMulGeneric(&c[1], &t2, &a[1])
+	fpMulGeneric(&c[0], &t0, &t1)
+}
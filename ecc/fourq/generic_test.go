@@ -0,0 +1,146 @@
+package fourq
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/cloudflare/circl/internal/test"
+)
+
+func randomFp(x *Fp) {
+	_, _ = rand.Read(x[:])
+	x[SizeFp-1] &= 0x7f
+}
+
+// TestFpGeneric checks that the portable implementation of the arithmetic
+// agrees with the one used by this build, which uses assembly in amd64
+// unless the noasm tag is set.
+func TestFpGeneric(t *testing.T) {
+	const testTimes = 1 << 12
+	var x, y, got, want Fp
+	pMinusOne := modulusP
+	pMinusOne[0]--
+	edge := []Fp{{}, {1}, pMinusOne, modulusP, {}, {}}
+	for i := 4; i < len(edge); i++ {
+		randomFp(&edge[i])
+	}
+
+	for _, op := range []struct {
+		name          string
+		generic, impl func(c, a, b *Fp)
+	}{
+		{"Add", fpAddGeneric, fpAdd},
+		{"Sub", fpSubGeneric, fpSub},
+		{"Mul", fpMulGeneric, fpMul},
+		{"Sqr",
+			func(c, a, _ *Fp) { fpSqrGeneric(c, a) },
+			func(c, a, _ *Fp) { fpSqr(c, a) }},
+		{"Hlf",
+			func(c, a, _ *Fp) { fpHlfGeneric(c, a) },
+			func(c, a, _ *Fp) { fpHlf(c, a) }},
+		{"Mod",
+			func(c, a, _ *Fp) { *c = *a; fpModGeneric(c) },
+			func(c, a, _ *Fp) { *c = *a; fpMod(c) }},
+	} {
+		t.Run(op.name, func(t *testing.T) {
+			for i := 0; i < testTimes; i++ {
+				if i < len(edge)*len(edge) {
+					x, y = edge[i/len(edge)], edge[i%len(edge)]
+				} else {
+					randomFp(&x)
+					randomFp(&y)
+				}
+				op.generic(&got, &x, &y)
+				op.impl(&want, &x, &y)
+				// Results are compared modulo p, as both implementations may
+				// return p instead of zero.
+				fpMod(&got)
+				fpMod(&want)
+				if got != want {
+					test.ReportError(t, got, want, x, y)
+				}
+			}
+		})
+	}
+}
+
+func TestFqGeneric(t *testing.T) {
+	const testTimes = 1 << 12
+	var x, y, got, want Fq
+	for _, op := range []struct {
+		name          string
+		generic, impl func(c, a, b *Fq)
+	}{
+		{"Add", fqAddGeneric, fqAdd},
+		{"Sub", fqSubGeneric, fqSub},
+		{"Mul", fqMulGeneric, fqMul},
+		{"Sqr",
+			func(c, a, _ *Fq) { fqSqrGeneric(c, a) },
+			func(c, a, _ *Fq) { fqSqr(c, a) }},
+		{"Cmov",
+			func(c, a, b *Fq) { *c = *a; fqCmovGeneric(c, b, int(a[0][0]&1)) },
+			func(c, a, b *Fq) { *c = *a; fqCmov(c, b, int(a[0][0]&1)) }},
+	} {
+		t.Run(op.name, func(t *testing.T) {
+			for i := 0; i < testTimes; i++ {
+				randomFp(&x[0])
+				randomFp(&x[1])
+				randomFp(&y[0])
+				randomFp(&y[1])
+				op.generic(&got, &x, &y)
+				op.impl(&want, &x, &y)
+				for j := range got {
+					fpMod(&got[j])
+					fpMod(&want[j])
+				}
+				if got != want {
+					test.ReportError(t, got, want, x, y)
+				}
+			}
+		})
+	}
+}
+
+func TestPointGeneric(t *testing.T) {
+	const testTimes = 1 << 10
+	var P, Q, got, want pointR1
+	var R2 pointR2
+	var R3 pointR3
+	for _, op := range []struct {
+		name          string
+		generic, impl func(P *pointR1)
+	}{
+		{"double", doubleGeneric, func(P *pointR1) { P.double() }},
+		{"add",
+			func(P *pointR1) { addGeneric(P, &R2) },
+			func(P *pointR1) { P.add(&R2) }},
+		{"mixAdd",
+			func(P *pointR1) { mixAddGeneric(P, &R3) },
+			func(P *pointR1) { P.mixAdd(&R3) }},
+	} {
+		t.Run(op.name, func(t *testing.T) {
+			for i := 0; i < testTimes; i++ {
+				P.random()
+				Q.random()
+				R2.FromR1(&Q)
+				if op.name == "mixAdd" {
+					// R3 points are affine, so Q must have Z=1.
+					Q.ToAffine()
+					R2.FromR1(&Q)
+					R3 = R2.pointR3
+				}
+
+				got, want = P, P
+				op.generic(&got)
+				op.impl(&want)
+				if !got.isEqual(&want) {
+					test.ReportError(t, got, want, P, Q)
+				}
+				got.ToAffine()
+				if !got.IsOnCurve() {
+					test.ReportError(t, got.IsOnCurve(), true, P, Q)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package fourq
+
+// Constants of the endomorphisms of FourQ, see endomorphisms.go.
+var (
+	// montA and montB are the coefficients of the Montgomery curve By^2=x^3+Ax^2+x,
+	// which is birationally equivalent to FourQ.
+	montA = Fq{
+		Fp{
+			0x09, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x70, 0xfc, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f,
+		},
+		Fp{
+			0x18, 0x68, 0x13, 0x3c, 0x73, 0xb5, 0xdc, 0xaa,
+			0x4f, 0xa1, 0x87, 0xf6, 0x5b, 0x83, 0x7a, 0x63,
+		},
+	}
+	montB = Fq{
+		Fp{
+			0xf4, 0xfa, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0x8f, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		},
+		Fp{
+			0xe7, 0x97, 0xec, 0xc3, 0x8c, 0x4a, 0x23, 0x55,
+			0xb0, 0x5e, 0x78, 0x09, 0xa4, 0x7c, 0x85, 0x1c,
+		},
+	}
+	// mont3B is 3*montB.
+	mont3B = Fq{
+		Fp{
+			0xdc, 0xf0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xaf, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		},
+		Fp{
+			0xb5, 0xc7, 0xc5, 0x4b, 0xa6, 0xdf, 0x69, 0xff,
+			0x10, 0x1c, 0x69, 0x1c, 0xec, 0x75, 0x90, 0x55,
+		},
+	}
+	// isoTau is the 2-isogeny τ from E to Ê.
+	isoTau = isogeny2{
+		x0: Fq{
+			Fp{
+				0x35, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x26, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+			},
+			Fp{
+				0xc2, 0xac, 0x54, 0x28, 0x6c, 0x03, 0xeb, 0x1d,
+				0x2a, 0x50, 0xb9, 0xbb, 0x40, 0xdd, 0x0b, 0x25,
+			},
+		},
+		v: Fq{
+			Fp{
+				0x91, 0x65, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80,
+				0xd1, 0x47, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08,
+			},
+			Fp{
+				0x1e, 0x93, 0xb1, 0xf3, 0x93, 0x91, 0x7d, 0x4d,
+				0xa2, 0x81, 0xab, 0x94, 0xc0, 0x67, 0x75, 0x4a,
+			},
+		},
+		u2: Fq{
+			Fp{
+				0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+		},
+		u3: Fq{
+			Fp{
+				0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+		},
+	}
+	// isoTauDual is the dual of τ, from Ê to E.
+	isoTauDual = isogeny2{
+		x0: Fq{
+			Fp{
+				0x94, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				0xb3, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f,
+			},
+			Fp{
+				0x7b, 0xa6, 0x56, 0xaf, 0x27, 0xf9, 0x29, 0xc4,
+				0xab, 0x5f, 0x8d, 0x88, 0x7e, 0x45, 0xe8, 0x35,
+			},
+		},
+		v: Fq{
+			Fp{
+				0xbd, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				0x1b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f,
+			},
+			Fp{
+				0x72, 0xf3, 0x03, 0x0e, 0x77, 0xeb, 0x7d, 0x4c,
+				0x03, 0x1f, 0xa8, 0x99, 0x7b, 0xd0, 0xb8, 0x21,
+			},
+		},
+		u2: Fq{
+			Fp{
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20,
+			},
+			Fp{
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+		},
+		u3: Fq{
+			Fp{
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+			},
+			Fp{
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+		},
+	}
+	// isoPsi is the endomorphism ψ of Ê, that is a 2-isogeny followed by the Frobenius map.
+	isoPsi = isogeny2{
+		x0: Fq{
+			Fp{
+				0x93, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				0xb3, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f,
+			},
+			Fp{
+				0x7b, 0xa6, 0x56, 0xaf, 0x27, 0xf9, 0x29, 0xc4,
+				0xab, 0x5f, 0x8d, 0x88, 0x7e, 0x45, 0xe8, 0x35,
+			},
+		},
+		v: Fq{
+			Fp{
+				0x43, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0xe4, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0x8d, 0x0c, 0xfc, 0xf1, 0x88, 0x14, 0x82, 0xb3,
+				0xfc, 0xe0, 0x57, 0x66, 0x84, 0x2f, 0x47, 0x5e,
+			},
+		},
+		u2: Fq{
+			Fp{
+				0xaa, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				0xc3, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x3f,
+			},
+			Fp{
+				0xf2, 0x65, 0x8b, 0x01, 0xc3, 0x4f, 0xa9, 0x0f,
+				0xbc, 0x43, 0xd7, 0x0a, 0x56, 0x94, 0x38, 0x51,
+			},
+		},
+		u3: Fq{
+			Fp{
+				0x1c, 0x09, 0xfd, 0xd0, 0xa9, 0x53, 0x20, 0x06,
+				0xb6, 0x9c, 0xf3, 0x02, 0x4c, 0xba, 0xa5, 0x42,
+			},
+			Fp{
+				0xdb, 0xf9, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f,
+				0xa8, 0xfb, 0xff, 0xff, 0xff, 0xff, 0xff, 0x3f,
+			},
+		},
+		frob: true,
+	}
+	// isoPhi2 is the 2-isogeny of φ, from the image of isoPhi5 to Ê.
+	isoPhi2 = isogeny2{
+		x0: Fq{
+			Fp{
+				0xe3, 0xfd, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				0x83, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f,
+			},
+			Fp{
+				0x69, 0x40, 0xb1, 0x6c, 0xc6, 0xdd, 0xd1, 0xd4,
+				0x5a, 0xde, 0xc2, 0xaa, 0x78, 0x5b, 0x89, 0x0d,
+			},
+		},
+		v: Fq{
+			Fp{
+				0xeb, 0x9c, 0x27, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0xb4, 0x02, 0x1c, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0x06, 0xdb, 0x8e, 0x60, 0x1b, 0xdd, 0x32, 0x81,
+				0x0f, 0x27, 0xb4, 0x13, 0x43, 0xb5, 0x62, 0x3b,
+			},
+		},
+		u2: Fq{
+			Fp{
+				0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66,
+				0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x26,
+			},
+			Fp{
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+		},
+		u3: Fq{
+			Fp{
+				0x35, 0xb8, 0xae, 0x54, 0xd7, 0x1a, 0x65, 0xaf,
+				0x93, 0x80, 0x31, 0xa0, 0xd7, 0x55, 0x11, 0x52,
+			},
+			Fp{
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+		},
+	}
+	// isoPhi5N has the coefficients of the numerator of the x-coordinate map of
+	// the 5-isogeny of φ.
+	isoPhi5N = [6]Fq{
+		{
+			Fp{
+				0x70, 0xf5, 0x9b, 0x16, 0xec, 0x0a, 0x00, 0x00,
+				0x20, 0xf0, 0x0c, 0x22, 0xb9, 0x07, 0x00, 0x00,
+			},
+			Fp{
+				0x0a, 0xdd, 0x0f, 0xbb, 0x65, 0x80, 0x2a, 0x15,
+				0x2a, 0x0c, 0x7f, 0xd3, 0x11, 0x05, 0x67, 0x46,
+			},
+		},
+		{
+			Fp{
+				0x8d, 0xfb, 0x12, 0x67, 0x16, 0x00, 0x00, 0x00,
+				0x88, 0x50, 0x4f, 0xd7, 0x0f, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0x2c, 0x3c, 0x0e, 0x12, 0x76, 0xdb, 0xc6, 0xfc,
+				0x6e, 0xe2, 0x5f, 0x73, 0xe1, 0x6e, 0x66, 0x79,
+			},
+		},
+		{
+			Fp{
+				0x00, 0x3a, 0xff, 0x11, 0x00, 0x00, 0x00, 0x00,
+				0x1c, 0xcd, 0xb9, 0x0c, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0x2b, 0x45, 0x74, 0x74, 0x75, 0x8d, 0xc5, 0xd3,
+				0xa3, 0x89, 0x33, 0x55, 0xb0, 0xb0, 0x16, 0x1f,
+			},
+		},
+		{
+			Fp{
+				0xc6, 0x87, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x20, 0x53, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0x1c, 0xa9, 0x60, 0xf0, 0x68, 0xf1, 0xa4, 0xc4,
+				0x76, 0x73, 0xae, 0xb0, 0xe0, 0x1a, 0x52, 0x0d,
+			},
+		},
+		{
+			Fp{
+				0xe0, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x54, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0xe0, 0x1d, 0xba, 0x56, 0xb0, 0xa2, 0xc4, 0x89,
+				0xca, 0x97, 0x2d, 0x06, 0x0c, 0x3f, 0xa5, 0x3e,
+			},
+		},
+		{
+			Fp{
+				0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+		},
+	}
+	// isoPhi5H has the coefficients of the kernel polynomial of the
+	// 5-isogeny of φ.
+	isoPhi5H = [3]Fq{
+		{
+			Fp{
+				0xab, 0xdf, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x28, 0x9e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0xdc, 0xc5, 0x12, 0xdc, 0x43, 0x6a, 0xb1, 0x16,
+				0x5e, 0xb1, 0xc8, 0xa5, 0x2b, 0x26, 0xb5, 0x0a,
+			},
+		},
+		{
+			Fp{
+				0xf0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0xaa, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0xf0, 0x0e, 0x5d, 0x2b, 0x58, 0x51, 0xe2, 0x44,
+				0xe5, 0xcb, 0x16, 0x03, 0x86, 0x9f, 0x52, 0x1f,
+			},
+		},
+		{
+			Fp{
+				0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+		},
+	}
+	// isoPhi5M has the coefficients of the numerator of the y-coordinate map of
+	// the 5-isogeny of φ.
+	isoPhi5M = [7]Fq{
+		{
+			Fp{
+				0x26, 0xe1, 0xe1, 0x7d, 0x37, 0x2f, 0xfc, 0xff,
+				0xdf, 0x06, 0xea, 0x58, 0x4f, 0x4d, 0xfd, 0x7f,
+			},
+			Fp{
+				0xbf, 0xab, 0xd1, 0x38, 0x77, 0x18, 0x73, 0x6c,
+				0x24, 0xd4, 0xb5, 0x09, 0x5e, 0x38, 0xdc, 0x7f,
+			},
+		},
+		{
+			Fp{
+				0xbf, 0xae, 0x18, 0x77, 0xe5, 0xfd, 0xff, 0xff,
+				0xa5, 0xb2, 0xca, 0x32, 0x83, 0xfe, 0xff, 0x7f,
+			},
+			Fp{
+				0xc1, 0xbf, 0x6e, 0x68, 0x87, 0x01, 0xd7, 0xf2,
+				0xb2, 0x22, 0xa7, 0xf0, 0xfb, 0xca, 0x7f, 0x20,
+			},
+		},
+		{
+			Fp{
+				0x37, 0xf7, 0x16, 0xbe, 0x0b, 0x00, 0x00, 0x00,
+				0xf8, 0x5c, 0xa0, 0x4d, 0x08, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0xb8, 0x6d, 0xcd, 0xd6, 0xe9, 0x9e, 0x25, 0x9d,
+				0x72, 0x2f, 0x00, 0x58, 0x37, 0x2b, 0x07, 0x46,
+			},
+		},
+		{
+			Fp{
+				0x10, 0x39, 0x95, 0x12, 0x00, 0x00, 0x00, 0x00,
+				0x54, 0xdd, 0x23, 0x0d, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0x08, 0xec, 0xb1, 0xb4, 0x76, 0x40, 0x3f, 0x4e,
+				0xce, 0x7e, 0xa6, 0x09, 0x97, 0x0b, 0x46, 0x23,
+			},
+		},
+		{
+			Fp{
+				0x71, 0xf7, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x28, 0xc1, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0x02, 0xa2, 0x23, 0x68, 0x10, 0x27, 0x13, 0x1c,
+				0x31, 0x97, 0x95, 0x15, 0x77, 0x35, 0x34, 0x06,
+			},
+		},
+		{
+			Fp{
+				0xd0, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0xfe, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0xd0, 0x2c, 0x17, 0x82, 0x08, 0xf4, 0xa6, 0xce,
+				0xaf, 0x63, 0x44, 0x09, 0x92, 0xde, 0xf7, 0x5d,
+			},
+		},
+		{
+			Fp{
+				0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			Fp{
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+		},
+	}
+)
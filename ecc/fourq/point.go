@@ -1,5 +1,3 @@
-// +build amd64,go1.12
-
 package fourq
 
 import (
@@ -21,21 +19,6 @@ type pointR2 struct {
 	z2 Fq // 2 * z
 }
 
-// subYDiv16 update x = (x - y) / 16
-func subYDiv16(x *[5]uint64, y int64) {
-	s := uint64(y >> 63)
-	x0, b0 := bits.Sub64((*x)[0], uint64(y), 0)
-	x1, b1 := bits.Sub64((*x)[1], s, b0)
-	x2, b2 := bits.Sub64((*x)[2], s, b1)
-	x3, b3 := bits.Sub64((*x)[3], s, b2)
-	x4, _ := bits.Sub64((*x)[4], s, b3)
-	(*x)[0] = (x0 >> 4) | (x1 << 60)
-	(*x)[1] = (x1 >> 4) | (x2 << 60)
-	(*x)[2] = (x2 >> 4) | (x3 << 60)
-	(*x)[3] = (x3 >> 4) | (x4 << 60)
-	(*x)[4] = (x4 >> 4)
-}
-
 // condAddOrderN updates x = x+order if x is even, otherwise x remains unchanged
 func condAddOrderN(x *[5]uint64) {
 	var o [4]uint64
@@ -55,51 +38,27 @@ func condAddOrderN(x *[5]uint64) {
 	(*x)[4] = x4
 }
 
-func recodeScalar(d *[65]int8, k *[32]byte) {
-	var m [5]uint64
-	m[0] = binary.LittleEndian.Uint64(k[0:8])
-	m[1] = binary.LittleEndian.Uint64(k[8:16])
-	m[2] = binary.LittleEndian.Uint64(k[16:24])
-	m[3] = binary.LittleEndian.Uint64(k[24:32])
-	condAddOrderN(&m)
-	for i := 0; i < 64; i++ {
-		d[i] = int8((m[0] & 0x1f) - 16)
-		subYDiv16(&m, int64(d[i]))
-	}
-	d[64] = int8(m[0])
-}
-
-func (P *pointR1) oddMultiples(T *[8]pointR2) {
-	var _2P, R pointR1
-	var _p2P pointR2
-	_2P.copy(P)
-	_2P.double()
-	_p2P.FromR1(&_2P)
-	R.copy(P)
-	T[0].FromR1(P)
-	for i := 1; i < 8; i++ {
-		R.add(&_p2P)
-		T[i].FromR1(&R)
-	}
-}
-
-// scalarMult calculates P = k*Q
+// ScalarMult calculates P = k*Q, where Q is an N-torsion point. It uses the
+// four-dimensional decomposition of k given by the endomorphisms of FourQ.
 func (P *pointR1) ScalarMult(k *[32]byte, Q *pointR1) {
 	var TabQ [8]pointR2
 	var S pointR2
+	var a [4]uint64
 	var d [65]int8
-	Q.oddMultiples(&TabQ)
-	recodeScalar(&d, k)
+	if Q.IsIdentity() {
+		P.SetIdentity()
+		return
+	}
+	Q.endoMultiples(&TabQ)
+	decompose(&a, k)
+	recodeMultiScalar(&d, &a)
 	P.SetIdentity()
 	for i := 64; i >= 0; i-- {
-		P.double()
-		P.double()
-		P.double()
 		P.double()
 		mask := d[i] >> 7
 		absDi := (d[i] + mask) ^ mask
-		inx := int((absDi - 1) >> 1)
-		sig := int((d[i] >> 7) & 0x1)
+		inx := int(absDi - 1)
+		sig := int(mask & 0x1)
 		for j := range TabQ {
 			S.cmov(&TabQ[j], int((uint64(uint32(inx^j))-1)>>63))
 		}
@@ -108,6 +67,44 @@ func (P *pointR1) ScalarMult(k *[32]byte, Q *pointR1) {
 	}
 }
 
+// doubleScalarMult calculates P = a*Q + b*G, where G is the generator point
+// and Q is an N-torsion point. This function runs in variable time.
+func (P *pointR1) doubleScalarMult(a *[Size]byte, Q *pointR1, b *[Size]byte) {
+	var TabQ, TabG [8]pointR2
+	var G pointR1
+	var aQ, aG [4]uint64
+	var dQ, dG [65]int8
+	if Q.IsIdentity() {
+		P.ScalarBaseMult(b)
+		return
+	}
+	G.X, G.Y, G.Ta, G.Tb = genX, genY, genX, genY
+	G.Z.setOne()
+	Q.endoMultiples(&TabQ)
+	G.endoMultiples(&TabG)
+	decompose(&aQ, a)
+	decompose(&aG, b)
+	recodeMultiScalar(&dQ, &aQ)
+	recodeMultiScalar(&dG, &aG)
+	P.SetIdentity()
+	for i := 64; i >= 0; i-- {
+		P.double()
+		P.addDigit(&TabQ, dQ[i])
+		P.addDigit(&TabG, dG[i])
+	}
+}
+
+// addDigit calculates P = P + sign(d)*T[|d|-1] in variable time.
+func (P *pointR1) addDigit(T *[8]pointR2, d int8) {
+	if d < 0 {
+		S := T[-d-1]
+		S.cneg(1)
+		P.add(&S)
+	} else {
+		P.add(&T[d-1])
+	}
+}
+
 // absolute returns always a positive value.
 func absolute(x int32) int32 {
 	mask := x >> 31
@@ -246,26 +243,35 @@ func (P *Point) Marshal(out *[Size]byte) {
 	out[Size-1] |= byte(b) << 7
 }
 
-// Unmarshal retrieves a point P from the input buffer. On success, returns true.
+// Unmarshal retrieves a point P from the input buffer. On success, returns
+// true. It returns false if the buffer does not encode a point on the curve,
+// in which case P remains unchanged. The input buffer is not modified.
 func (P *Point) Unmarshal(in *[Size]byte) bool {
-	s := in[Size-1] >> 7
-	in[Size-1] &= 0x7F
-	if ok := P.Y.fromBytes(in[:]); !ok {
+	var Q Point
+	var buf [Size]byte
+	copy(buf[:], in[:])
+	s := buf[Size-1] >> 7
+	buf[Size-1] &= 0x7F
+	if ok := Q.Y.fromBytes(buf[:]); !ok {
 		return ok
 	}
-	in[Size-1] |= s << 7
 
 	t0, t1, one := &Fq{}, &Fq{}, &Fq{}
 	one.setOne()
-	fqSqr(t0, &P.Y)                  // t0 = y^2
+	fqSqr(t0, &Q.Y)                  // t0 = y^2
 	fqMul(t1, t0, &paramD)           // t1 = d*y^2
 	fqSub(t0, t0, one)               // t0 = y^2 - 1
 	fqAdd(t1, t1, one)               // t1 = d*y^2 + 1
-	fqSqrt(&P.X, t0, t1, 1-2*int(s)) // x = sqrt(t0/t1)
+	fqSqrt(&Q.X, t0, t1, 1-2*int(s)) // x = sqrt(t0/t1)
 
-	if !P.IsOnCurve() {
-		fpNeg(&P.X[1], &P.X[1])
+	if !Q.IsOnCurve() {
+		fpNeg(&Q.X[1], &Q.X[1])
+	}
+	// If t0/t1 is not a square, neither candidate for x is valid.
+	if !Q.IsOnCurve() {
+		return false
 	}
+	*P = Q
 	return true
 }
 
@@ -303,6 +309,24 @@ func (P *pointR1) isEqual(Q *pointR1) bool {
 	return b
 }
 
+// isTorsionN reports whether P is an N-torsion point, that is, whether [N]P
+// is the identity. It uses a plain double-and-add, since the endomorphisms
+// only act as scalars on N-torsion points. This function runs in variable
+// time.
+func (P *pointR1) isTorsionN() bool {
+	var Q pointR2
+	var R pointR1
+	Q.FromR1(P)
+	R.SetIdentity()
+	for i := 64*len(orderGenerator) - 1; i >= 0; i-- {
+		R.double()
+		if (orderGenerator[i/64]>>uint(i%64))&1 == 1 {
+			R.add(&Q)
+		}
+	}
+	return R.IsIdentity()
+}
+
 func (P *pointR1) ClearCofactor() {
 	var Q pointR2
 	Q.FromR1(P)
@@ -0,0 +1,53 @@
+package fourq
+
+// doubleGeneric calculates P = 2P.
+func doubleGeneric(P *pointR1) {
+	a, b, c, d, e, f, g := &Fq{}, &Fq{}, &Fq{}, &Fq{}, &Fq{}, &Fq{}, &Fq{}
+	fqAdd(e, &P.X, &P.Y) // e = x + y
+	fqSqr(a, &P.X)       // a = x^2
+	fqSqr(b, &P.Y)       // b = y^2
+	fqSqr(c, &P.Z)       // c = z^2
+	fqAdd(c, c, c)       // c = 2z^2
+	fqAdd(d, a, b)       // d = x^2 + y^2
+	fqSqr(e, e)          // e = (x + y)^2
+	fqSub(e, e, d)       // e = 2xy
+	fqSub(f, b, a)       // f = y^2 - x^2
+	fqSub(g, c, f)       // g = 2z^2 - y^2 + x^2
+	fqMul(&P.Z, f, g)
+	fqMul(&P.X, e, g)
+	fqMul(&P.Y, d, f)
+	P.Ta, P.Tb = *d, *e
+}
+
+// addGeneric calculates P = P + Q.
+func addGeneric(P *pointR1, Q *pointR2) {
+	var d Fq
+	fqMul(&d, &P.Z, &Q.z2) // d = 2*z1*z2
+	addCommon(P, &Q.pointR3, &d)
+}
+
+// mixAddGeneric calculates P = P + Q, where Q has z-coordinate equal to 1.
+func mixAddGeneric(P *pointR1, Q *pointR3) {
+	var d Fq
+	fqAdd(&d, &P.Z, &P.Z) // d = 2*z1
+	addCommon(P, Q, &d)
+}
+
+// addCommon calculates P = P + Q, where d = 2*z1*z2 is given.
+func addCommon(P *pointR1, Q *pointR3, d *Fq) {
+	a, b, c, e, f, g, h := &Fq{}, &Fq{}, &Fq{}, &Fq{}, &Fq{}, &Fq{}, &Fq{}
+	fqMul(c, &P.Ta, &P.Tb) // c = t1
+	fqSub(h, &P.Y, &P.X)   // h = y1 - x1
+	fqAdd(b, &P.Y, &P.X)   // b = y1 + x1
+	fqMul(a, h, &Q.subYX)  // a = (y1 - x1)*(y2 - x2)
+	fqMul(b, b, &Q.addYX)  // b = (y1 + x1)*(y2 + x2)
+	fqSub(e, b, a)         // e = b - a
+	fqAdd(h, b, a)         // h = b + a
+	fqMul(c, c, &Q.dt2)    // c = 2*d*t1*t2
+	fqSub(f, d, c)         // f = d - c
+	fqAdd(g, d, c)         // g = d + c
+	fqMul(&P.Z, f, g)
+	fqMul(&P.X, e, f)
+	fqMul(&P.Y, g, h)
+	P.Ta, P.Tb = *e, *h
+}
@@ -1,10 +1,10 @@
-// +build amd64,go1.12
-
 package fourq
 
 import (
 	"crypto/rand"
+	"encoding/binary"
 	"math/big"
+	"math/bits"
 	"testing"
 
 	"github.com/cloudflare/circl/internal/conv"
@@ -17,6 +17,75 @@ func (P *pointR1) random() {
 	P.ScalarBaseMult(&k)
 }
 
+// subYDiv16 update x = (x - y) / 16
+func subYDiv16(x *[5]uint64, y int64) {
+	s := uint64(y >> 63)
+	x0, b0 := bits.Sub64((*x)[0], uint64(y), 0)
+	x1, b1 := bits.Sub64((*x)[1], s, b0)
+	x2, b2 := bits.Sub64((*x)[2], s, b1)
+	x3, b3 := bits.Sub64((*x)[3], s, b2)
+	x4, _ := bits.Sub64((*x)[4], s, b3)
+	(*x)[0] = (x0 >> 4) | (x1 << 60)
+	(*x)[1] = (x1 >> 4) | (x2 << 60)
+	(*x)[2] = (x2 >> 4) | (x3 << 60)
+	(*x)[3] = (x3 >> 4) | (x4 << 60)
+	(*x)[4] = (x4 >> 4)
+}
+
+func recodeScalar(d *[65]int8, k *[32]byte) {
+	var m [5]uint64
+	m[0] = binary.LittleEndian.Uint64(k[0:8])
+	m[1] = binary.LittleEndian.Uint64(k[8:16])
+	m[2] = binary.LittleEndian.Uint64(k[16:24])
+	m[3] = binary.LittleEndian.Uint64(k[24:32])
+	condAddOrderN(&m)
+	for i := 0; i < 64; i++ {
+		d[i] = int8((m[0] & 0x1f) - 16)
+		subYDiv16(&m, int64(d[i]))
+	}
+	d[64] = int8(m[0])
+}
+
+func (P *pointR1) oddMultiples(T *[8]pointR2) {
+	var _2P, R pointR1
+	var _p2P pointR2
+	_2P.copy(P)
+	_2P.double()
+	_p2P.FromR1(&_2P)
+	R.copy(P)
+	T[0].FromR1(P)
+	for i := 1; i < 8; i++ {
+		R.add(&_p2P)
+		T[i].FromR1(&R)
+	}
+}
+
+// scalarMultWindowed calculates P = k*Q using a fixed-window method with
+// eight odd multiples of Q. It is used as a reference for ScalarMult.
+func (P *pointR1) scalarMultWindowed(k *[32]byte, Q *pointR1) {
+	var TabQ [8]pointR2
+	var S pointR2
+	var d [65]int8
+	Q.oddMultiples(&TabQ)
+	recodeScalar(&d, k)
+	P.SetIdentity()
+	for i := 64; i >= 0; i-- {
+		P.double()
+		P.double()
+		P.double()
+		P.double()
+		mask := d[i] >> 7
+		absDi := (d[i] + mask) ^ mask
+		inx := int((absDi - 1) >> 1)
+		sig := int((d[i] >> 7) & 0x1)
+		for j := range TabQ {
+			S.cmov(&TabQ[j], int((uint64(uint32(inx^j))-1)>>63))
+		}
+		S.cneg(sig)
+		P.add(&S)
+	}
+}
+
 func TestPointAddition(t *testing.T) {
 	const testTimes = 1 << 10
 	var P, Q pointR1
@@ -70,7 +139,7 @@ func TestOddMultiples(t *testing.T) {
 
 func TestScalarMult(t *testing.T) {
 	const testTimes = 1 << 10
-	var P, Q, G pointR1
+	var P, Q, R, G pointR1
 	var k [Size]byte
 
 	t.Run("0P=0", func(t *testing.T) {
@@ -109,6 +178,49 @@ func TestScalarMult(t *testing.T) {
 			}
 		}
 	})
+	t.Run("identity", func(t *testing.T) {
+		_, _ = rand.Read(k[:])
+		P.SetIdentity()
+		Q.ScalarMult(&k, &P)
+		got := Q.IsIdentity()
+		want := true
+		if got != want {
+			test.ReportError(t, got, want, k)
+		}
+	})
+	t.Run("windowed", func(t *testing.T) {
+		for i := 0; i < testTimes; i++ {
+			P.random()
+			_, _ = rand.Read(k[:])
+			Q.ScalarMult(&k, &P)
+			R.scalarMultWindowed(&k, &P)
+			got := Q.isEqual(&R)
+			want := true
+			if got != want {
+				test.ReportError(t, got, want, k, P)
+			}
+		}
+	})
+	t.Run("doubleMult", func(t *testing.T) {
+		var l [Size]byte
+		var B pointR1
+		var S pointR2
+		for i := 0; i < testTimes; i++ {
+			P.random()
+			_, _ = rand.Read(k[:])
+			_, _ = rand.Read(l[:])
+			Q.doubleScalarMult(&k, &P, &l)
+			R.ScalarMult(&k, &P)
+			B.ScalarBaseMult(&l)
+			S.FromR1(&B)
+			R.add(&S)
+			got := Q.isEqual(&R)
+			want := true
+			if got != want {
+				test.ReportError(t, got, want, k, l, P)
+			}
+		}
+	})
 	t.Run("mult", func(t *testing.T) {
 		G.X = genX
 		G.Y = genY
@@ -264,4 +376,20 @@ func BenchmarkPoint(b *testing.B) {
 			P.ScalarMult(&k, &R)
 		}
 	})
+	b.Run("doubleScmul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.doubleScalarMult(&k, &R, &k)
+		}
+	})
+	b.Run("scmulWindowed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.scalarMultWindowed(&k, &R)
+		}
+	})
+	b.Run("endoMultiples", func(b *testing.B) {
+		var T [8]pointR2
+		for i := 0; i < b.N; i++ {
+			R.endoMultiples(&T)
+		}
+	})
 }
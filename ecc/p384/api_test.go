@@ -1,5 +1,3 @@
-// +build arm64 amd64
-
 package p384_test
 
 import (
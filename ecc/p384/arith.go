@@ -1,9 +1,9 @@
-// +build arm64 amd64
-
 package p384
 
 import (
+	"crypto/subtle"
 	"math/big"
+	"math/bits"
 
 	"github.com/cloudflare/circl/internal/conv"
 )
@@ -22,6 +22,63 @@ func (e *fp384) SetBigInt(b *big.Int) {
 	conv.BigInt2BytesLe(e[:], b)
 }
 
+// fp384SetBytes sets e to the Montgomery encoding of the big-endian integer
+// in b, which must have sizeFp bytes. It returns false if the integer is not
+// less than p, in which case e is not modified.
+func fp384SetBytes(e *fp384, b []byte) bool {
+	var t fp384
+	for i := range t {
+		t[i] = b[sizeFp-1-i]
+	}
+	var w fp384Words64
+	var borrow uint64
+	t.toWords(&w)
+	for i := range w {
+		_, borrow = bits.Sub64(w[i], pWords[i], borrow)
+	}
+	if borrow == 0 {
+		return false
+	}
+	montEncode(e, &t)
+	return true
+}
+
+// fp384Bytes stores in b the big-endian encoding of the Montgomery-encoded
+// element e. The slice b must have sizeFp bytes.
+func fp384Bytes(b []byte, e *fp384) {
+	var t fp384
+	montDecode(&t, e)
+	for i := range t {
+		b[sizeFp-1-i] = t[i]
+	}
+}
+
+// fp384IsZero returns 1 if e is zero, and 0 otherwise. Runs in constant time.
+func fp384IsZero(e *fp384) int { return fp384IsEqual(e, &fp384{}) }
+
+// fp384IsEqual returns 1 if a and b are equal, and 0 otherwise. Runs in
+// constant time.
+func fp384IsEqual(a, b *fp384) int { return subtle.ConstantTimeCompare(a[:], b[:]) }
+
+// fp384Sqrt calculates z = sqrt(x) and returns 1 if x is a square.
+// Otherwise, it returns 0 and z is set to sqrt(-x). Runs in constant time.
+func fp384Sqrt(z, x *fp384) int {
+	// Since p = 3 mod 4, a square root of x is x^((p+1)/4).
+	t := &fp384{}
+	montEncode(t, &fp384{1})
+	for i := 8*sizeFp - 1; i >= 0; i-- {
+		fp384Sqr(t, t)
+		if (pPlus1Div4[i/8]>>uint(i%8))&1 == 1 {
+			fp384Mul(t, t, x)
+		}
+	}
+	t2 := &fp384{}
+	fp384Sqr(t2, t)
+	isSquare := fp384IsEqual(t2, x)
+	*z = *t
+	return isSquare
+}
+
 func montEncode(c, a *fp384) { fp384Mul(c, a, &r2) }
 func montDecode(c, a *fp384) { fp384Mul(c, a, &fp384{1}) }
 func fp384Sqr(c, a *fp384)   { fp384Mul(c, a, a) }
@@ -115,21 +172,6 @@ func fp384Inv(z, x *fp384) {
 	fp384Mul(z, t4, t1)
 }
 
-//go:noescape
-func fp384Cmov(x, y *fp384, b int)
-
-//go:noescape
-func fp384Neg(c, a *fp384)
-
-//go:noescape
-func fp384Add(c, a, b *fp384)
-
-//go:noescape
-func fp384Sub(c, a, b *fp384)
-
-//go:noescape
-func fp384Mul(c, a, b *fp384)
-
 var (
 	// p is the order of the base field, represented as little-endian 64-bit words.
 	p = fp384{
@@ -152,6 +194,13 @@ var (
 		0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 	}
+	// pPlus1Div4 is (p+1)/4, used as exponent for square roots.
+	pPlus1Div4 = fp384{
+		0x00, 0x00, 0x00, 0x40, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc0,
+		0xff, 0xff, 0xff, 0xbf, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x3f,
+	}
 	// bb is the Montgomery encoding of the curve parameter B.
 	bb = fp384{
 		0xcc, 0x2d, 0x41, 0x9d, 0x71, 0x88, 0x11, 0x08, 0xec, 0x32, 0x4c, 0x7a,
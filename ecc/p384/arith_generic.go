@@ -0,0 +1,152 @@
+package p384
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// fp384Words is the number of 64-bit words of a field element.
+const fp384Words = sizeFp / 8
+
+type fp384Words64 [fp384Words]uint64
+
+// pWords is the prime p, represented as little-endian 64-bit words.
+var pWords = fp384Words64{
+	0x00000000ffffffff, 0xffffffff00000000, 0xfffffffffffffffe,
+	0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff,
+}
+
+// pPrime is -1/p mod 2^64.
+const pPrime = 0x0000000100000001
+
+func (e *fp384) toWords(w *fp384Words64) {
+	for i := range w {
+		w[i] = binary.LittleEndian.Uint64(e[8*i : 8*i+8])
+	}
+}
+
+func (e *fp384) fromWords(w *fp384Words64) {
+	for i := range w {
+		binary.LittleEndian.PutUint64(e[8*i:8*i+8], w[i])
+	}
+}
+
+// reduceWords calculates z = (hi*2^384 + z) mod p, given that the input is
+// less than 2p, in constant time.
+func reduceWords(z *fp384Words64, hi uint64) { modReduceWords(z, hi, &pWords) }
+
+// modReduceWords calculates z = (hi*2^384 + z) mod m, given that the input is
+// less than 2m, in constant time.
+func modReduceWords(z *fp384Words64, hi uint64, m *fp384Words64) {
+	var t fp384Words64
+	var borrow uint64
+	for i := range t {
+		t[i], borrow = bits.Sub64(z[i], m[i], borrow)
+	}
+	// If hi*2^384 + z < m, there is a borrow; then, z is kept.
+	_, borrow = bits.Sub64(hi, 0, borrow)
+	mask := borrow - 1
+	for i := range z {
+		z[i] = (z[i] &^ mask) | (t[i] & mask)
+	}
+}
+
+// fp384CmovGeneric assigns y to x if b is not zero, in constant time.
+func fp384CmovGeneric(x, y *fp384, b int) {
+	v := uint64(b)
+	mask := -((v | -v) >> 63)
+	var xx, yy fp384Words64
+	x.toWords(&xx)
+	y.toWords(&yy)
+	for i := range xx {
+		xx[i] = (xx[i] &^ mask) | (yy[i] & mask)
+	}
+	x.fromWords(&xx)
+}
+
+// fp384NegGeneric calculates c = -a mod p.
+func fp384NegGeneric(c, a *fp384) {
+	var aa, cc fp384Words64
+	var borrow uint64
+	a.toWords(&aa)
+	reduceWords(&aa, 0)
+	for i := range cc {
+		cc[i], borrow = bits.Sub64(pWords[i], aa[i], borrow)
+	}
+	reduceWords(&cc, 0) // if a = 0, then c = p is reduced to 0.
+	c.fromWords(&cc)
+}
+
+// fp384AddGeneric calculates c = a + b mod p.
+func fp384AddGeneric(c, a, b *fp384) {
+	var aa, bb fp384Words64
+	var carry uint64
+	a.toWords(&aa)
+	b.toWords(&bb)
+	reduceWords(&aa, 0)
+	reduceWords(&bb, 0)
+	for i := range aa {
+		aa[i], carry = bits.Add64(aa[i], bb[i], carry)
+	}
+	reduceWords(&aa, carry)
+	c.fromWords(&aa)
+}
+
+// fp384SubGeneric calculates c = a - b mod p.
+func fp384SubGeneric(c, a, b *fp384) {
+	var t fp384
+	fp384NegGeneric(&t, b)
+	fp384AddGeneric(c, a, &t)
+}
+
+// fp384MulGeneric calculates c = a*b/R mod p, where R = 2^384, using
+// Montgomery multiplication.
+func fp384MulGeneric(c, a, b *fp384) {
+	var aa, bb, z fp384Words64
+	a.toWords(&aa)
+	b.toWords(&bb)
+	montMulWords(&z, &aa, &bb, &pWords, pPrime)
+	c.fromWords(&z)
+}
+
+// montMulWords calculates z = x*y/R mod m, where R = 2^384 and mPrime is
+// -1/m mod 2^64, given that x and y are less than 2^384. The output is fully
+// reduced. Runs in constant time.
+func montMulWords(z, x, y, m *fp384Words64, mPrime uint64) {
+	var t fp384Words64
+	var hi uint64
+	for i := range x {
+		// t = t + x[i]*y
+		var carry uint64
+		for j := range y {
+			h, l := bits.Mul64(x[i], y[j])
+			var c0, c1 uint64
+			l, c0 = bits.Add64(l, t[j], 0)
+			l, c1 = bits.Add64(l, carry, 0)
+			t[j] = l
+			carry = h + c0 + c1
+		}
+		var top uint64
+		hi, top = bits.Add64(hi, carry, 0)
+
+		// t = (t + u*m)/2^64, where u = -t/m mod 2^64.
+		u := t[0] * mPrime
+		carry = 0
+		for j := range m {
+			h, l := bits.Mul64(u, m[j])
+			var c0, c1 uint64
+			l, c0 = bits.Add64(l, t[j], 0)
+			l, c1 = bits.Add64(l, carry, 0)
+			if j > 0 {
+				t[j-1] = l
+			}
+			carry = h + c0 + c1
+		}
+		t[fp384Words-1], carry = bits.Add64(hi, carry, 0)
+		hi = top + carry
+	}
+	// Since t < R + m < 3m, two subtractions give a reduced result.
+	modReduceWords(&t, hi, m)
+	modReduceWords(&t, 0, m)
+	*z = t
+}
@@ -0,0 +1,9 @@
+// +build noasm !amd64,!arm64
+
+package p384
+
+func fp384Cmov(x, y *fp384, b int) { fp384CmovGeneric(x, y, b) }
+func fp384Neg(c, a *fp384)         { fp384NegGeneric(c, a) }
+func fp384Add(c, a, b *fp384)      { fp384AddGeneric(c, a, b) }
+func fp384Sub(c, a, b *fp384)      { fp384SubGeneric(c, a, b) }
+func fp384Mul(c, a, b *fp384)      { fp384MulGeneric(c, a, b) }
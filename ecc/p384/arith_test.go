@@ -1,5 +1,3 @@
-// +build arm64 amd64
-
 package p384
 
 import (
@@ -221,6 +219,88 @@ func TestFp(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("Sqrt", func(t *testing.T) {
+		z2 := &fp384{}
+		for i := 0; i < testTimes; i++ {
+			_, _ = rand.Read(x[:])
+			bigX := x.BigInt()
+			x.SetBigInt(bigX.Mod(bigX, P))
+
+			// fp384
+			got := fp384Sqrt(z, x)
+
+			// big.Int
+			bigA := bigX.Mul(bigX, &bigRinv).Mod(bigX, P)
+			want := 0
+			if big.Jacobi(bigA, P) >= 0 {
+				want = 1
+			}
+			if got != want {
+				test.ReportError(t, got, want, x)
+			}
+			// z^2 = x if x is a square, otherwise z^2 = -x.
+			fp384Sqr(z2, z)
+			if got == 0 {
+				fp384Neg(z2, z2)
+			}
+			if *z2 != *x {
+				test.ReportError(t, *z2, *x, x)
+			}
+		}
+	})
+}
+
+// TestFpGeneric checks that the portable implementation of the arithmetic
+// agrees with the one used by this build, which uses assembly in amd64 and
+// arm64 unless the noasm tag is set.
+func TestFpGeneric(t *testing.T) {
+	const testTimes = 1 << 12
+	var x, y, got, want fp384
+	pMinusOne := p
+	pMinusOne[0]--
+	edge := []fp384{{}, {1}, pMinusOne, p, {}, {}, {}, {}}
+	for i := range edge {
+		if i > 3 {
+			_, _ = rand.Read(edge[i][:])
+		}
+	}
+
+	for _, op := range []struct {
+		name          string
+		generic, impl func(c, a, b *fp384)
+	}{
+		{"Add", fp384AddGeneric, fp384Add},
+		{"Sub", fp384SubGeneric, fp384Sub},
+		{"Mul", fp384MulGeneric, fp384Mul},
+		{"Neg",
+			func(c, a, _ *fp384) { fp384NegGeneric(c, a) },
+			func(c, a, _ *fp384) { fp384Neg(c, a) }},
+		{"Cmov",
+			func(c, a, b *fp384) { *c = *a; fp384CmovGeneric(c, b, int(a[0]&1)) },
+			func(c, a, b *fp384) { *c = *a; fp384Cmov(c, b, int(a[0]&1)) }},
+	} {
+		t.Run(op.name, func(t *testing.T) {
+			for i := 0; i < testTimes; i++ {
+				if i < len(edge)*len(edge) {
+					x, y = edge[i/len(edge)], edge[i%len(edge)]
+				} else {
+					_, _ = rand.Read(x[:])
+					_, _ = rand.Read(y[:])
+				}
+				op.generic(&got, &x, &y)
+				op.impl(&want, &x, &y)
+				// Results are compared modulo p, as the assembly may return
+				// values in [p, 2^384).
+				P := p.BigInt()
+				g := new(big.Int).Mod(got.BigInt(), P)
+				w := new(big.Int).Mod(want.BigInt(), P)
+				if g.Cmp(w) != 0 {
+					test.ReportError(t, got, want, x, y)
+				}
+			}
+		})
+	}
 }
 
 func BenchmarkFp(b *testing.B) {
@@ -255,4 +335,10 @@ func BenchmarkFp(b *testing.B) {
 			fp384Inv(z, x)
 		}
 	})
+
+	b.Run("MulGeneric", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			fp384MulGeneric(z, x, y)
+		}
+	})
 }
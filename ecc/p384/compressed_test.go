@@ -0,0 +1,47 @@
+// +build go1.15
+
+package p384_test
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/cloudflare/circl/ecc/p384"
+	"github.com/cloudflare/circl/internal/test"
+)
+
+func TestCompressed(t *testing.T) {
+	const testTimes = 1 << 7
+	StdCurve := elliptic.P384()
+	var P p384.Point
+	var k [p384.ScalarSize]byte
+	var enc [p384.CompressedSize]byte
+
+	for i := 0; i < testTimes; i++ {
+		_, _ = rand.Read(k[:])
+		x, y := StdCurve.ScalarBaseMult(k[:])
+		want := elliptic.MarshalCompressed(StdCurve, x, y)
+
+		got := p384.MarshalCompressed(x, y)
+		if !bytes.Equal(got, want) {
+			test.ReportError(t, got, want, k)
+		}
+
+		copy(enc[:], want)
+		if !P.UnmarshalCompressed(&enc) {
+			test.ReportError(t, false, true, k)
+		}
+		P.MarshalCompressed(&enc)
+		if !bytes.Equal(enc[:], want) {
+			test.ReportError(t, enc, want, k)
+		}
+
+		gotX, gotY := p384.UnmarshalCompressed(want)
+		wantX, wantY := elliptic.UnmarshalCompressed(StdCurve, want)
+		if gotX.Cmp(wantX) != 0 || gotY.Cmp(wantY) != 0 {
+			test.ReportError(t, gotX, wantX, k)
+		}
+	}
+}
@@ -4,8 +4,28 @@
 //  - Around 10x faster in amd64 architecture.
 //  - Reduced number of memory allocations.
 //  - Native support for arm64 architecture.
+//  - Portable Go implementation for other architectures, which is also
+//    selected with the noasm build tag.
 //  - ScalarMult is perfomed using a constant-time algorithm.
 //  - ScalarBaseMult fallbacks into ScalarMult.
 //  - A new method included for double-point multiplication.
 //
+// Besides the elliptic.Curve interface, the Point and Scalar types provide
+// an API that does not use big.Int. Points have fixed-size SEC 1 encodings,
+// both uncompressed and compressed, and decoding checks that the result is a
+// point of the curve. Scalar multiplications take fixed-size scalars and run
+// in constant time. The Curve returned by P384 is a thin wrapper around these
+// types, and MarshalCompressed and UnmarshalCompressed provide compressed
+// encodings for big.Int coordinates.
+//
+// The package also implements ECDSA signatures on P-384, interoperable with
+// the key types of crypto/ecdsa. Nonces are derived deterministically as in
+// RFC-6979, optionally hedged with additional randomness. Signatures are
+// encoded either in ASN.1 DER or as the fixed-width concatenation r||s.
+//
+// Hashing to the curve follows RFC-9380 with the suites
+// P384_XMD:SHA-384_SSWU_RO_ (HashToCurve) and P384_XMD:SHA-384_SSWU_NU_
+// (EncodeToCurve), which use expand_message_xmd with SHA-384 and the
+// simplified SWU map.
+//
 package p384
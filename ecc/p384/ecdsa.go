@@ -0,0 +1,285 @@
+package p384
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"encoding/asn1"
+	"errors"
+	"io"
+	"math/big"
+)
+
+// SignatureSize is the length in bytes of a signature in fixed-width
+// encoding, that is, the concatenation r||s of two 48-byte big-endian
+// integers (as in IEEE P1363).
+const SignatureSize = 2 * sizeFp
+
+var (
+	errInvalidKey  = errors.New("p384: invalid private key")
+	errBadRandom   = errors.New("p384: cannot read randomness")
+	errInvalidHash = errors.New("p384: hash function not available")
+	errHashSize    = errors.New("p384: hash length does not match the hash function")
+)
+
+// GenerateKey returns a new ECDSA key pair on the P-384 curve. The Curve
+// field of the returned key is set to P384().
+func GenerateKey(rand io.Reader) (*ecdsa.PrivateKey, error) {
+	return ecdsa.GenerateKey(P384(), rand)
+}
+
+// Sign computes an ECDSA signature of a hash using the private key priv.
+// The hash must be the output of the hash function given by opts.HashFunc(),
+// or of SHA-384 if opts is nil; an error is returned if its length does not
+// match the size of that function. As in SEC 1, Section 4.1.3, a hash longer
+// than 48 bytes is converted to an integer using its leftmost 48 bytes only.
+//
+// The nonce is derived deterministically as specified in RFC-6979, using
+// HMAC with the same hash function. If rand is not nil, 48 bytes read from
+// rand are mixed into the derivation as additional data (see RFC-6979,
+// Section 3.6); such hedged signatures are randomized but remain secure in
+// case rand fails to be unpredictable.
+// Arithmetic on the private key and the nonce runs in constant time.
+//
+// The private key can use either P384() or elliptic.P384() as its curve.
+func Sign(rand io.Reader, priv *ecdsa.PrivateKey, hash []byte, opts crypto.SignerOpts) (r, s *big.Int, err error) {
+	N := P384().Params().N
+	if priv == nil || priv.D == nil || !isP384(priv.Curve) ||
+		priv.D.Sign() <= 0 || priv.D.Cmp(N) >= 0 {
+		return nil, nil, errInvalidKey
+	}
+	H := crypto.SHA384
+	if opts != nil && opts.HashFunc() != 0 {
+		H = opts.HashFunc()
+	}
+	if !H.Available() {
+		return nil, nil, errInvalidHash
+	}
+	if len(hash) != H.Size() {
+		return nil, nil, errHashSize
+	}
+
+	var extra []byte
+	if rand != nil {
+		extra = make([]byte, sizeFp)
+		if _, err = io.ReadFull(rand, extra); err != nil {
+			return nil, nil, errBadRandom
+		}
+	}
+
+	var d, e, k, rr, ss Scalar
+	var x, h, kb [sizeFp]byte
+	fillBytes(x[:], priv.D)
+	d.Unmarshal(&x)
+	e.SetBytes(truncateHash(hash))
+	e.Marshal(&h)
+	g := newNonceGenerator(H, x[:], h[:], extra)
+
+	var R Point
+	var enc [UncompressedSize]byte
+	for {
+		copy(kb[:], g.next())
+		if !k.Unmarshal(&kb) || k.IsZero() {
+			continue
+		}
+		R.ScalarBaseMult(&kb)
+		R.Marshal(&enc)
+		rr.SetBytes(enc[1 : 1+sizeFp])
+		if rr.IsZero() {
+			continue
+		}
+		k.Inv(&k)
+		ss.Mul(&rr, &d)
+		ss.Add(&ss, &e)
+		ss.Mul(&ss, &k)
+		if !ss.IsZero() {
+			return scalarToInt(&rr), scalarToInt(&ss), nil
+		}
+	}
+}
+
+// Verify reports whether (r,s) is a valid ECDSA signature of hash by the
+// public key pub. If hash is longer than 48 bytes, only its leftmost 48 bytes
+// are used.
+//
+// The public key can use either P384() or elliptic.P384() as its curve.
+func Verify(pub *ecdsa.PublicKey, hash []byte, r, s *big.Int) bool {
+	if !isValidPublicKey(pub) || r == nil || s == nil {
+		return false
+	}
+	N := P384().Params().N
+	if r.Sign() <= 0 || s.Sign() <= 0 || r.Cmp(N) >= 0 || s.Cmp(N) >= 0 {
+		return false
+	}
+
+	e := new(big.Int).SetBytes(truncateHash(hash))
+	w := new(big.Int).ModInverse(s, N)
+	u1 := e.Mul(e, w)
+	u1.Mod(u1, N)
+	u2 := w.Mul(r, w)
+	u2.Mod(u2, N)
+
+	c := P384()
+	x, y := c.CombinedMult(pub.X, pub.Y, u1.Bytes(), u2.Bytes())
+	if c.IsAtInfinity(x, y) {
+		return false
+	}
+	x.Mod(x, N)
+	return x.Cmp(r) == 0
+}
+
+type asn1Signature struct{ R, S *big.Int }
+
+// SignASN1 computes an ECDSA signature of hash as Sign does, and returns it
+// encoded as an ASN.1 DER sequence of the integers r and s.
+func SignASN1(rand io.Reader, priv *ecdsa.PrivateKey, hash []byte, opts crypto.SignerOpts) ([]byte, error) {
+	r, s, err := Sign(rand, priv, hash, opts)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(asn1Signature{r, s})
+}
+
+// VerifyASN1 reports whether sig is a valid ECDSA signature of hash by the
+// public key pub, where sig is encoded as an ASN.1 DER sequence. Encodings
+// that are not strictly DER are rejected.
+func VerifyASN1(pub *ecdsa.PublicKey, hash, sig []byte) bool {
+	var rs asn1Signature
+	rest, err := asn1.Unmarshal(sig, &rs)
+	if err != nil || len(rest) != 0 {
+		return false
+	}
+	// Re-encoding guarantees that only the unique DER form is accepted.
+	der, err := asn1.Marshal(rs)
+	if err != nil || !bytes.Equal(der, sig) {
+		return false
+	}
+	return Verify(pub, hash, rs.R, rs.S)
+}
+
+// SignFixed computes an ECDSA signature of hash as Sign does, and returns it
+// in fixed-width encoding of SignatureSize bytes.
+func SignFixed(rand io.Reader, priv *ecdsa.PrivateKey, hash []byte, opts crypto.SignerOpts) ([]byte, error) {
+	r, s, err := Sign(rand, priv, hash, opts)
+	if err != nil {
+		return nil, err
+	}
+	sig := make([]byte, SignatureSize)
+	fillBytes(sig[:sizeFp], r)
+	fillBytes(sig[sizeFp:], s)
+	return sig, nil
+}
+
+// VerifyFixed reports whether sig is a valid ECDSA signature of hash by the
+// public key pub, where sig is in fixed-width encoding of SignatureSize bytes.
+func VerifyFixed(pub *ecdsa.PublicKey, hash, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+	r := new(big.Int).SetBytes(sig[:sizeFp])
+	s := new(big.Int).SetBytes(sig[sizeFp:])
+	return Verify(pub, hash, r, s)
+}
+
+// isP384 reports whether c is either this package's curve or the curve
+// P-384 of crypto/elliptic.
+func isP384(c elliptic.Curve) bool {
+	if c == nil {
+		return false
+	}
+	if _, ok := c.(curve); ok {
+		return true
+	}
+	return c.Params().Name == elliptic.P384().Params().Name
+}
+
+// isValidPublicKey reports whether pub is a point of P-384 different from
+// the identity and with coordinates in canonical form.
+func isValidPublicKey(pub *ecdsa.PublicKey) bool {
+	if pub == nil || pub.X == nil || pub.Y == nil || !isP384(pub.Curve) {
+		return false
+	}
+	P := P384().Params().P
+	if pub.X.Sign() < 0 || pub.Y.Sign() < 0 ||
+		pub.X.Cmp(P) >= 0 || pub.Y.Cmp(P) >= 0 {
+		return false
+	}
+	c := P384()
+	return !c.IsAtInfinity(pub.X, pub.Y) && c.IsOnCurve(pub.X, pub.Y)
+}
+
+// truncateHash returns the leftmost 384 bits of a hash, which are converted
+// into an integer as specified in SEC 1, Section 4.1.3.
+func truncateHash(hash []byte) []byte {
+	if len(hash) > sizeFp {
+		hash = hash[:sizeFp]
+	}
+	return hash
+}
+
+// fillBytes sets buf to the big-endian encoding of the non-negative integer
+// x, which must fit in len(buf) bytes.
+func fillBytes(buf []byte, x *big.Int) {
+	b := x.Bytes()
+	for i := range buf[:len(buf)-len(b)] {
+		buf[i] = 0
+	}
+	copy(buf[len(buf)-len(b):], b)
+}
+
+// scalarToInt converts a scalar into a big.Int.
+func scalarToInt(s *Scalar) *big.Int {
+	var b [ScalarSize]byte
+	s.Marshal(&b)
+	return new(big.Int).SetBytes(b[:])
+}
+
+// nonceGenerator is the HMAC_DRBG of RFC-6979, Section 3.2, instantiated
+// with the hash function used to compute the message hash. Each call to next
+// returns a candidate nonce, which is formed by the leftmost 384 bits of as
+// many HMAC outputs as needed.
+type nonceGenerator struct {
+	h       crypto.Hash
+	k, v    []byte
+	t       []byte
+	started bool
+}
+
+func newNonceGenerator(h crypto.Hash, x, hash, extra []byte) *nonceGenerator {
+	g := &nonceGenerator{
+		h: h,
+		k: make([]byte, h.Size()),
+		v: bytes.Repeat([]byte{0x01}, h.Size()),
+	}
+	g.k = g.mac(g.k, g.v, []byte{0x00}, x, hash, extra)
+	g.v = g.mac(g.k, g.v)
+	g.k = g.mac(g.k, g.v, []byte{0x01}, x, hash, extra)
+	g.v = g.mac(g.k, g.v)
+	return g
+}
+
+func (g *nonceGenerator) mac(key []byte, data ...[]byte) []byte {
+	m := hmac.New(g.h.New, key)
+	for _, d := range data {
+		_, _ = m.Write(d)
+	}
+	return m.Sum(nil)
+}
+
+// next returns a new candidate nonce. After the first call, the state is
+// updated before generating output, as required when a candidate is rejected.
+func (g *nonceGenerator) next() []byte {
+	if g.started {
+		g.k = g.mac(g.k, g.v, []byte{0x00})
+		g.v = g.mac(g.k, g.v)
+	}
+	g.started = true
+	g.t = g.t[:0]
+	for len(g.t) < sizeFp {
+		g.v = g.mac(g.k, g.v)
+		g.t = append(g.t, g.v...)
+	}
+	return g.t[:sizeFp]
+}
@@ -0,0 +1,305 @@
+package p384_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/circl/ecc/p384"
+	"github.com/cloudflare/circl/internal/test"
+)
+
+func hexInt(t *testing.T, s string) *big.Int {
+	t.Helper()
+	x, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		t.Fatalf("bad test vector: %v", s)
+	}
+	return x
+}
+
+// Test vectors taken from Appendix A.2.6 of RFC-6979 (SHA-256, SHA-384 and
+// SHA-512).
+func TestECDSARFC6979(t *testing.T) {
+	priv := &ecdsa.PrivateKey{D: hexInt(t, "6B9D3DAD2E1B8C1C05B19875B6659F4DE23C3B667BF297BA9AA47740787137D896D5724E4C70A825F872C9EA60D2EDF5")}
+	priv.Curve = p384.P384()
+	priv.X, priv.Y = priv.Curve.ScalarBaseMult(priv.D.Bytes())
+	wantX := hexInt(t, "EC3A4E415B4E19A4568618029F427FA5DA9A8BC4AE92E02E06AAE5286B300C64DEF8F0EA9055866064A254515480BC13")
+	if priv.X.Cmp(wantX) != 0 {
+		test.ReportError(t, priv.X, wantX)
+	}
+
+	vectors := []struct {
+		hash      crypto.Hash
+		msg, r, s string
+	}{
+		{
+			crypto.SHA256,
+			"sample",
+			"21B13D1E013C7FA1392D03C5F99AF8B30C570C6F98D4EA8E354B63A21D3DAA33BDE1E888E63355D92FA2B3C36D8FB2CD",
+			"F3AA443FB107745BF4BD77CB3891674632068A10CA67E3D45DB2266FA7D1FEEBEFDC63ECCD1AC42EC0CB8668A4FA0AB0",
+		},
+		{
+			crypto.SHA384,
+			"sample",
+			"94EDBB92A5ECB8AAD4736E56C691916B3F88140666CE9FA73D64C4EA95AD133C81A648152E44ACF96E36DD1E80FABE46",
+			"99EF4AEB15F178CEA1FE40DB2603138F130E740A19624526203B6351D0A3A94FA329C145786E679E7B82C71A38628AC8",
+		},
+		{
+			crypto.SHA512,
+			"sample",
+			"ED0959D5880AB2D869AE7F6C2915C6D60F96507F9CB3E047C0046861DA4A799CFE30F35CC900056D7C99CD7882433709",
+			"512C8CCEEE3890A84058CE1E22DBC2198F42323CE8ACA9135329F03C068E5112DC7CC3EF3446DEFCEB01A45C2667FDD5",
+		},
+		{
+			crypto.SHA256,
+			"test",
+			"6D6DEFAC9AB64DABAFE36C6BF510352A4CC27001263638E5B16D9BB51D451559F918EEDAF2293BE5B475CC8F0188636B",
+			"2D46F3BECBCC523D5F1A1256BF0C9B024D879BA9E838144C8BA6BAEB4B53B47D51AB373F9845C0514EEFB14024787265",
+		},
+		{
+			crypto.SHA384,
+			"test",
+			"8203B63D3C853E8D77227FB377BCF7B7B772E97892A80F36AB775D509D7A5FEB0542A7F0812998DA8F1DD3CA3CF023DB",
+			"DDD0760448D42D8A43AF45AF836FCE4DE8BE06B485E9B61B827C2F13173923E06A739F040649A667BF3B828246BAA5A5",
+		},
+		{
+			crypto.SHA512,
+			"test",
+			"A0D5D090C9980FAF3C2CE57B7AE951D31977DD11C775D314AF55F76C676447D06FB6495CD21B4B6E340FC236584FB277",
+			"976984E59B4C77B0E8E4460DCA3D9F20E07B9BB1F63BEEFAF576F6B2E8B224634A2092CD3792E0159AD9CEE37659C736",
+		},
+	}
+	for i, v := range vectors {
+		hh := v.hash.New()
+		_, _ = hh.Write([]byte(v.msg))
+		h := hh.Sum(nil)
+		r, s, err := p384.Sign(nil, priv, h, v.hash)
+		test.CheckNoErr(t, err, "sign failed")
+		wantR, wantS := hexInt(t, v.r), hexInt(t, v.s)
+		if r.Cmp(wantR) != 0 || s.Cmp(wantS) != 0 {
+			test.ReportError(t, r, wantR, i, s, wantS)
+		}
+		if !p384.Verify(&priv.PublicKey, h, r, s) {
+			test.ReportError(t, false, true, i)
+		}
+	}
+}
+
+func TestECDSA(t *testing.T) {
+	const testTimes = 1 << 6
+	var msg [64]byte
+
+	t.Run("interop", func(t *testing.T) {
+		for i := 0; i < testTimes; i++ {
+			priv, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+			test.CheckNoErr(t, err, "keygen failed")
+			_, _ = rand.Read(msg[:])
+			h := sha512.Sum384(msg[:])
+
+			r, s, err := p384.Sign(rand.Reader, priv, h[:], nil)
+			test.CheckNoErr(t, err, "sign failed")
+			if !ecdsa.Verify(&priv.PublicKey, h[:], r, s) {
+				test.ReportError(t, false, true, priv.D, msg)
+			}
+
+			r, s, err = ecdsa.Sign(rand.Reader, priv, h[:])
+			test.CheckNoErr(t, err, "sign failed")
+			if !p384.Verify(&priv.PublicKey, h[:], r, s) {
+				test.ReportError(t, false, true, priv.D, msg)
+			}
+		}
+	})
+
+	t.Run("encodings", func(t *testing.T) {
+		priv, err := p384.GenerateKey(rand.Reader)
+		test.CheckNoErr(t, err, "keygen failed")
+		pub := &priv.PublicKey
+		for i := 0; i < testTimes; i++ {
+			_, _ = rand.Read(msg[:])
+			h := sha512.Sum384(msg[:])
+
+			sig, err := p384.SignASN1(nil, priv, h[:], crypto.SHA384)
+			test.CheckNoErr(t, err, "sign failed")
+			if !p384.VerifyASN1(pub, h[:], sig) {
+				test.ReportError(t, false, true, msg)
+			}
+			sig, err = p384.SignFixed(nil, priv, h[:], nil)
+			test.CheckNoErr(t, err, "sign failed")
+			if len(sig) != p384.SignatureSize || !p384.VerifyFixed(pub, h[:], sig) {
+				test.ReportError(t, false, true, msg)
+			}
+
+			h[0] ^= 0x1
+			if p384.VerifyFixed(pub, h[:], sig) {
+				test.ReportError(t, true, false, msg)
+			}
+		}
+	})
+
+	t.Run("hedged", func(t *testing.T) {
+		priv, err := p384.GenerateKey(rand.Reader)
+		test.CheckNoErr(t, err, "keygen failed")
+		_, _ = rand.Read(msg[:])
+		h := sha512.Sum384(msg[:])
+
+		sig0, _ := p384.SignFixed(nil, priv, h[:], nil)
+		sig1, _ := p384.SignFixed(nil, priv, h[:], nil)
+		if !bytes.Equal(sig0, sig1) {
+			test.ReportError(t, sig0, sig1, msg)
+		}
+		sig2, _ := p384.SignFixed(rand.Reader, priv, h[:], nil)
+		sig3, _ := p384.SignFixed(rand.Reader, priv, h[:], nil)
+		if bytes.Equal(sig2, sig0) || bytes.Equal(sig2, sig3) {
+			test.ReportError(t, sig2, sig3, msg)
+		}
+		if !p384.VerifyFixed(&priv.PublicKey, h[:], sig2) ||
+			!p384.VerifyFixed(&priv.PublicKey, h[:], sig3) {
+			test.ReportError(t, false, true, msg)
+		}
+	})
+
+	t.Run("hashSize", func(t *testing.T) {
+		priv, err := p384.GenerateKey(rand.Reader)
+		test.CheckNoErr(t, err, "keygen failed")
+		h256 := sha256.Sum256(msg[:])
+		h512 := sha512.Sum512(msg[:])
+		for i, c := range []struct {
+			hash []byte
+			opts crypto.SignerOpts
+			ok   bool
+		}{
+			{h256[:], crypto.SHA256, true},
+			{h512[:], crypto.SHA512, true},
+			{h256[:], nil, false},
+			{h512[:], crypto.SHA384, false},
+			{h512[:48], crypto.SHA512, false},
+			{append(h512[:], 0), crypto.SHA512, false},
+			{nil, nil, false},
+		} {
+			r, s, err := p384.Sign(nil, priv, c.hash, c.opts)
+			if (err == nil) != c.ok {
+				test.ReportError(t, err, c.ok, i)
+			}
+			if c.ok && !ecdsa.Verify(&priv.PublicKey, c.hash, r, s) {
+				test.ReportError(t, false, true, i)
+			}
+			if _, err := p384.SignASN1(nil, priv, c.hash, c.opts); (err == nil) != c.ok {
+				test.ReportError(t, err, c.ok, i)
+			}
+			if _, err := p384.SignFixed(nil, priv, c.hash, c.opts); (err == nil) != c.ok {
+				test.ReportError(t, err, c.ok, i)
+			}
+		}
+	})
+
+	t.Run("invalidKey", func(t *testing.T) {
+		priv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if _, _, err := p384.Sign(nil, priv, msg[:48], nil); err == nil {
+			test.ReportError(t, err, "error", priv.D)
+		}
+		r, s, _ := ecdsa.Sign(rand.Reader, priv, msg[:])
+		if p384.Verify(&priv.PublicKey, msg[:], r, s) {
+			test.ReportError(t, true, false, priv.D)
+		}
+	})
+}
+
+type wycheproofECDSA struct {
+	Groups []struct {
+		Key struct {
+			Curve string `json:"curve"`
+			Wx    string `json:"wx"`
+			Wy    string `json:"wy"`
+		} `json:"publicKey"`
+		Sha   string `json:"sha"`
+		Tests []struct {
+			TcID    int    `json:"tcId"`
+			Comment string `json:"comment"`
+			Msg     string `json:"msg"`
+			Sig     string `json:"sig"`
+			Result  string `json:"result"`
+		} `json:"tests"`
+	} `json:"testGroups"`
+}
+
+func (kat *wycheproofECDSA) readFile(t *testing.T, fileName string) {
+	jsonFile, err := os.Open(fileName)
+	if err != nil {
+		t.Fatalf("File %v can not be opened. Error: %v", fileName, err)
+	}
+	defer jsonFile.Close()
+	input, _ := ioutil.ReadAll(jsonFile)
+
+	err = json.Unmarshal(input, &kat)
+	if err != nil {
+		t.Fatalf("File %v can not be loaded. Error: %v", fileName, err)
+	}
+}
+
+func (kat *wycheproofECDSA) verify(t *testing.T, verify func(*ecdsa.PublicKey, []byte, []byte) bool) {
+	for i, g := range kat.Groups {
+		if g.Key.Curve != "secp384r1" || g.Sha != "SHA-384" {
+			t.Fatalf("unexpected group %v %v", g.Key.Curve, g.Sha)
+		}
+		pub := &ecdsa.PublicKey{
+			Curve: p384.P384(),
+			X:     hexInt(t, g.Key.Wx),
+			Y:     hexInt(t, g.Key.Wy),
+		}
+		for _, v := range g.Tests {
+			msg, err := hex.DecodeString(v.Msg)
+			test.CheckNoErr(t, err, "bad message")
+			sig, err := hex.DecodeString(v.Sig)
+			test.CheckNoErr(t, err, "bad signature")
+			h := sha512.Sum384(msg)
+
+			got := verify(pub, h[:], sig)
+			want := v.Result == "valid"
+			if got != want {
+				test.ReportError(t, got, want, i, v.TcID, v.Comment)
+			}
+		}
+	}
+}
+
+func TestECDSAWycheproof(t *testing.T) {
+	// Test vectors from Wycheproof (C2SP), testvectors_v1.
+	t.Run("ASN1", func(t *testing.T) {
+		var kat wycheproofECDSA
+		kat.readFile(t, "testdata/ecdsa_secp384r1_sha384_test.json")
+		kat.verify(t, p384.VerifyASN1)
+	})
+	t.Run("Fixed", func(t *testing.T) {
+		var kat wycheproofECDSA
+		kat.readFile(t, "testdata/ecdsa_secp384r1_sha384_p1363_test.json")
+		kat.verify(t, p384.VerifyFixed)
+	})
+}
+
+func BenchmarkECDSA(b *testing.B) {
+	priv, _ := p384.GenerateKey(rand.Reader)
+	h := sha512.Sum384(nil)
+	sig, _ := p384.SignFixed(nil, priv, h[:], nil)
+
+	b.Run("Sign", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = p384.SignFixed(nil, priv, h[:], nil)
+		}
+	})
+	b.Run("Verify", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			p384.VerifyFixed(&priv.PublicKey, h[:], sig)
+		}
+	})
+}
@@ -0,0 +1,195 @@
+package p384
+
+import (
+	"crypto/subtle"
+	"math/bits"
+)
+
+const (
+	// CompressedSize is the length in bytes of a point in SEC 1 compressed
+	// form.
+	CompressedSize = 1 + sizeFp
+	// UncompressedSize is the length in bytes of a point in SEC 1
+	// uncompressed form.
+	UncompressedSize = 1 + 2*sizeFp
+)
+
+// Point represents a point of the P-384 curve. The zero value is not a valid
+// point, use SetIdentity or SetGenerator to initialize it.
+type Point struct{ p projectivePoint }
+
+// SetIdentity sets P to the point at infinity.
+func (P *Point) SetIdentity() { P.p = *zeroPoint().toProjective() }
+
+// SetGenerator sets P to the generator point of the group.
+func (P *Point) SetGenerator() { P.p = *baseOddMultiples[0].toProjective() }
+
+// IsIdentity returns true if P is the point at infinity. Runs in constant time.
+func (P *Point) IsIdentity() bool { return fp384IsZero(&P.p.z) == 1 }
+
+// IsEqual returns true if P and Q represent the same point. Runs in constant
+// time.
+func (P *Point) IsEqual(Q *Point) bool {
+	var l, r fp384
+	fp384Mul(&l, &P.p.x, &Q.p.z)
+	fp384Mul(&r, &Q.p.x, &P.p.z)
+	eqX := fp384IsEqual(&l, &r)
+	fp384Mul(&l, &P.p.y, &Q.p.z)
+	fp384Mul(&r, &Q.p.y, &P.p.z)
+	eqY := fp384IsEqual(&l, &r)
+	return eqX&eqY == 1
+}
+
+// IsOnCurve returns true if P is a point of the curve, including the point
+// at infinity.
+func (P *Point) IsOnCurve() bool {
+	// Y^2*Z = X^3 - 3*X*Z^2 + b*Z^3
+	X, Y, Z := &P.p.x, &P.p.y, &P.p.z
+	var l, r, t, z2 fp384
+	fp384Sqr(&l, Y)
+	fp384Mul(&l, &l, Z)
+	fp384Sqr(&z2, Z)
+	fp384Sqr(&r, X)
+	fp384Add(&t, &z2, &z2)
+	fp384Add(&t, &t, &z2)
+	fp384Sub(&r, &r, &t)
+	fp384Mul(&r, &r, X)
+	fp384Mul(&t, &bb, &z2)
+	fp384Mul(&t, &t, Z)
+	fp384Add(&r, &r, &t)
+	isInf := fp384IsZero(X) & (1 - fp384IsZero(Y)) & fp384IsZero(Z)
+	return (fp384IsEqual(&l, &r)&(1-fp384IsZero(Z)))|isInf == 1
+}
+
+// Neg calculates P = -Q.
+func (P *Point) Neg(Q *Point) { *P = *Q; P.p.neg() }
+
+// Add calculates P = Q + R. Runs in constant time.
+func (P *Point) Add(Q, R *Point) { P.p.completeAdd(&Q.p, &R.p) }
+
+// Sub calculates P = Q - R. Runs in constant time.
+func (P *Point) Sub(Q, R *Point) {
+	var S Point
+	S.Neg(R)
+	P.Add(Q, &S)
+}
+
+// Double calculates P = 2Q. Runs in constant time.
+func (P *Point) Double(Q *Point) { P.p.completeAdd(&Q.p, &Q.p) }
+
+// ScalarMult calculates P = kQ, where k is a big-endian integer of
+// ScalarSize bytes. Runs in constant time.
+func (P *Point) ScalarMult(k *[ScalarSize]byte, Q *Point) {
+	var s Scalar
+	s.SetBytes(k[:])
+	P.scalarMultOmega(&s, Q, 5)
+}
+
+// ScalarBaseMult calculates P = kG, where G is the generator point of the
+// group, and k is a big-endian integer of ScalarSize bytes. Runs in constant
+// time.
+func (P *Point) ScalarBaseMult(k *[ScalarSize]byte) {
+	var G Point
+	G.SetGenerator()
+	P.ScalarMult(k, &G)
+}
+
+// scalarMultOmega calculates P = kQ using a signed fixed-window recoding of
+// the scalar with windows of omega bits.
+func (P *Point) scalarMultOmega(k *Scalar, Q *Point, omega uint) {
+	// The scalar is made odd by replacing an even k with N-k, which requires
+	// negating the result. Note that k=0 is replaced with N.
+	var oddK Scalar
+	var borrow uint64
+	for i := range oddK.k {
+		oddK.k[i], borrow = bits.Sub64(nWords[i], k.k[i], borrow)
+	}
+	isEvenK := 1 - k.isOdd()
+	oddK.cmov(k, 1-isEvenK)
+	L := oddK.signedDigits(omega)
+
+	var R jacobianPoint
+	S := zeroPoint().toJacobian()
+	TabP := Q.p.toAffine().oddMultiples(omega)
+	for i := len(L) - 1; i > 0; i-- {
+		for j := uint(0); j < omega-1; j++ {
+			S.double()
+		}
+		idx := absolute(L[i]) >> 1
+		for j := range TabP {
+			R.cmov(&TabP[j], subtle.ConstantTimeEq(int32(j), idx))
+		}
+		R.cneg(int(L[i]>>31) & 1)
+		S.add(S, &R)
+	}
+	// Calculate the last iteration using complete addition formula.
+	for j := uint(0); j < omega-1; j++ {
+		S.double()
+	}
+	idx := absolute(L[0]) >> 1
+	for j := range TabP {
+		R.cmov(&TabP[j], subtle.ConstantTimeEq(int32(j), idx))
+	}
+	R.cneg(int(L[0]>>31) & 1)
+	P.p = *S.toProjective()
+	P.p.completeAdd(&P.p, R.toProjective())
+	P.p.cneg(isEvenK)
+}
+
+// Marshal encodes P in SEC 1 uncompressed form. The point at infinity, which
+// has no such encoding, is encoded with both coordinates set to zero, and is
+// rejected by Unmarshal.
+func (P *Point) Marshal(out *[UncompressedSize]byte) {
+	aP := P.p.toAffine()
+	out[0] = 0x04
+	fp384Bytes(out[1:1+sizeFp], &aP.x)
+	fp384Bytes(out[1+sizeFp:], &aP.y)
+}
+
+// MarshalCompressed encodes P in SEC 1 compressed form. The point at
+// infinity, which has no such encoding, is encoded with the x-coordinate set
+// to zero.
+func (P *Point) MarshalCompressed(out *[CompressedSize]byte) {
+	var y [sizeFp]byte
+	aP := P.p.toAffine()
+	fp384Bytes(out[1:], &aP.x)
+	fp384Bytes(y[:], &aP.y)
+	out[0] = 0x02 | (y[sizeFp-1] & 0x1)
+}
+
+// Unmarshal sets P from its SEC 1 uncompressed form. It returns false if the
+// encoding is not canonical or does not represent a point of the curve, in
+// which case P is not modified.
+func (P *Point) Unmarshal(in *[UncompressedSize]byte) bool {
+	var aP affinePoint
+	if in[0] != 0x04 ||
+		!fp384SetBytes(&aP.x, in[1:1+sizeFp]) ||
+		!fp384SetBytes(&aP.y, in[1+sizeFp:]) ||
+		!aP.isOnCurve() {
+		return false
+	}
+	P.p = *aP.toProjective()
+	return true
+}
+
+// UnmarshalCompressed sets P from its SEC 1 compressed form. It returns false
+// if the encoding is not canonical or does not represent a point of the
+// curve, in which case P is not modified.
+func (P *Point) UnmarshalCompressed(in *[CompressedSize]byte) bool {
+	var aP affinePoint
+	if (in[0] != 0x02 && in[0] != 0x03) || !fp384SetBytes(&aP.x, in[1:]) {
+		return false
+	}
+	curveRHS(&aP.y, &aP.x)
+	if fp384Sqrt(&aP.y, &aP.y) == 0 {
+		return false
+	}
+	// Selects the root whose parity matches the prefix.
+	var y [sizeFp]byte
+	var negY fp384
+	fp384Bytes(y[:], &aP.y)
+	fp384Neg(&negY, &aP.y)
+	fp384Cmov(&aP.y, &negY, int((y[sizeFp-1]^in[0])&0x1))
+	P.p = *aP.toProjective()
+	return true
+}
@@ -0,0 +1,302 @@
+package p384_test
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/cloudflare/circl/ecc/p384"
+	"github.com/cloudflare/circl/internal/test"
+)
+
+func randomScalar(t testing.TB) (s p384.Scalar) {
+	err := s.Random(rand.Reader)
+	test.CheckNoErr(t, err, "random scalar failed")
+	return
+}
+
+func scalarToInt(s *p384.Scalar) *big.Int {
+	var b [p384.ScalarSize]byte
+	s.Marshal(&b)
+	return new(big.Int).SetBytes(b[:])
+}
+
+// fillBytes stores x in b as a big-endian integer, padded with zeros.
+func fillBytes(b []byte, x *big.Int) {
+	for i := range b {
+		b[i] = 0
+	}
+	xb := x.Bytes()
+	copy(b[len(b)-len(xb):], xb)
+}
+
+func TestScalar(t *testing.T) {
+	const testTimes = 1 << 8
+	N := elliptic.P384().Params().N
+
+	t.Run("setBytes", func(t *testing.T) {
+		var s p384.Scalar
+		for _, l := range []int{0, 1, 20, 47, 48, 49, 64, 96, 100} {
+			b := make([]byte, l)
+			_, _ = rand.Read(b)
+			s.SetBytes(b)
+			got := scalarToInt(&s)
+			want := new(big.Int).SetBytes(b)
+			want.Mod(want, N)
+			if got.Cmp(want) != 0 {
+				test.ReportError(t, got, want, b)
+			}
+		}
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var s p384.Scalar
+		var b [p384.ScalarSize]byte
+		nMinus1 := new(big.Int).Sub(N, big.NewInt(1))
+		for _, v := range []struct {
+			k    *big.Int
+			want bool
+		}{
+			{big.NewInt(0), true},
+			{nMinus1, true},
+			{N, false},
+			{new(big.Int).Add(N, big.NewInt(1)), false},
+			{new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 384), big.NewInt(1)), false},
+		} {
+			fillBytes(b[:], v.k)
+			got := s.Unmarshal(&b)
+			if got != v.want {
+				test.ReportError(t, got, v.want, v.k)
+			}
+		}
+	})
+
+	t.Run("arith", func(t *testing.T) {
+		var s p384.Scalar
+		for i := 0; i < testTimes; i++ {
+			x, y := randomScalar(t), randomScalar(t)
+			X, Y := scalarToInt(&x), scalarToInt(&y)
+			want := new(big.Int)
+
+			s.Add(&x, &y)
+			want.Add(X, Y).Mod(want, N)
+			if got := scalarToInt(&s); got.Cmp(want) != 0 {
+				test.ReportError(t, got, want, X, Y)
+			}
+			s.Sub(&x, &y)
+			want.Sub(X, Y).Mod(want, N)
+			if got := scalarToInt(&s); got.Cmp(want) != 0 {
+				test.ReportError(t, got, want, X, Y)
+			}
+			s.Neg(&x)
+			want.Neg(X).Mod(want, N)
+			if got := scalarToInt(&s); got.Cmp(want) != 0 {
+				test.ReportError(t, got, want, X)
+			}
+			s.Mul(&x, &y)
+			want.Mul(X, Y).Mod(want, N)
+			if got := scalarToInt(&s); got.Cmp(want) != 0 {
+				test.ReportError(t, got, want, X, Y)
+			}
+			s.Inv(&x)
+			want.ModInverse(X, N)
+			if got := scalarToInt(&s); got.Cmp(want) != 0 {
+				test.ReportError(t, got, want, X)
+			}
+			s.Mul(&s, &x)
+			if got := scalarToInt(&s); got.Cmp(big.NewInt(1)) != 0 {
+				test.ReportError(t, got, 1, X)
+			}
+		}
+	})
+}
+
+func TestPoint(t *testing.T) {
+	const testTimes = 1 << 7
+	StdCurve := elliptic.P384()
+	params := StdCurve.Params()
+
+	t.Run("scalarBaseMult", func(t *testing.T) {
+		var P p384.Point
+		var k [p384.ScalarSize]byte
+		var got [p384.UncompressedSize]byte
+		for i := 0; i < testTimes; i++ {
+			_, _ = rand.Read(k[:])
+			P.ScalarBaseMult(&k)
+			P.Marshal(&got)
+			x, y := StdCurve.ScalarBaseMult(k[:])
+			want := elliptic.Marshal(StdCurve, x, y)
+			if !bytes.Equal(got[:], want) {
+				test.ReportError(t, got, want, k)
+			}
+		}
+	})
+
+	t.Run("identity", func(t *testing.T) {
+		var P, G p384.Point
+		var k [p384.ScalarSize]byte
+		G.SetGenerator()
+		P.ScalarMult(&k, &G)
+		if !P.IsIdentity() {
+			test.ReportError(t, P.IsIdentity(), true, k)
+		}
+		fillBytes(k[:], params.N)
+		P.ScalarMult(&k, &G)
+		if !P.IsIdentity() || !P.IsOnCurve() {
+			test.ReportError(t, P.IsIdentity(), true, k)
+		}
+
+		var enc [p384.UncompressedSize]byte
+		P.Marshal(&enc)
+		if P.Unmarshal(&enc) {
+			test.ReportError(t, true, false, enc)
+		}
+	})
+
+	t.Run("arith", func(t *testing.T) {
+		var G, P, Q, R, S p384.Point
+		var a, b, c [p384.ScalarSize]byte
+		G.SetGenerator()
+		for i := 0; i < testTimes; i++ {
+			x, y := randomScalar(t), randomScalar(t)
+			var z p384.Scalar
+			z.Add(&x, &y)
+			x.Marshal(&a)
+			y.Marshal(&b)
+			z.Marshal(&c)
+
+			// aG + bG = (a+b)G
+			P.ScalarBaseMult(&a)
+			Q.ScalarMult(&b, &G)
+			R.Add(&P, &Q)
+			S.ScalarBaseMult(&c)
+			if !R.IsEqual(&S) || !R.IsOnCurve() {
+				test.ReportError(t, R, S, x, y)
+			}
+
+			// (P + P) - 2P = 0
+			R.Add(&P, &P)
+			S.Double(&P)
+			R.Sub(&R, &S)
+			if !R.IsIdentity() {
+				test.ReportError(t, R.IsIdentity(), true, x)
+			}
+
+			// P + (-P) = 0
+			S.Neg(&P)
+			R.Add(&P, &S)
+			if !R.IsIdentity() {
+				test.ReportError(t, R.IsIdentity(), true, x)
+			}
+		}
+	})
+
+	t.Run("marshal", func(t *testing.T) {
+		var P, Q p384.Point
+		var k [p384.ScalarSize]byte
+		var enc [p384.UncompressedSize]byte
+		var cEnc [p384.CompressedSize]byte
+		for i := 0; i < testTimes; i++ {
+			_, _ = rand.Read(k[:])
+			P.ScalarBaseMult(&k)
+			P.Marshal(&enc)
+			if !Q.Unmarshal(&enc) || !Q.IsEqual(&P) {
+				test.ReportError(t, Q, P, k)
+			}
+
+			P.MarshalCompressed(&cEnc)
+			want := append([]byte{0x02 | enc[p384.UncompressedSize-1]&1}, enc[1:1+48]...)
+			if !bytes.Equal(cEnc[:], want) {
+				test.ReportError(t, cEnc, want, k)
+			}
+			if !Q.UnmarshalCompressed(&cEnc) || !Q.IsEqual(&P) {
+				test.ReportError(t, Q, P, k)
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var P p384.Point
+		var k [p384.ScalarSize]byte
+		var enc [p384.UncompressedSize]byte
+		_, _ = rand.Read(k[:])
+		P.ScalarBaseMult(&k)
+		P.Marshal(&enc)
+
+		bad := enc
+		bad[0] = 0x02
+		if P.Unmarshal(&bad) {
+			test.ReportError(t, true, false, bad)
+		}
+		bad = enc
+		bad[p384.UncompressedSize-1] ^= 1
+		if P.Unmarshal(&bad) {
+			test.ReportError(t, true, false, bad)
+		}
+		// x-coordinate is not reduced modulo p.
+		bad = enc
+		fillBytes(bad[1:1+48], params.P)
+		if P.Unmarshal(&bad) {
+			test.ReportError(t, true, false, bad)
+		}
+
+		var cEnc, cBad [p384.CompressedSize]byte
+		P.MarshalCompressed(&cEnc)
+		cBad = cEnc
+		cBad[0] = 0x04
+		if P.UnmarshalCompressed(&cBad) {
+			test.ReportError(t, true, false, cBad)
+		}
+		cBad = cEnc
+		fillBytes(cBad[1:], params.P)
+		if P.UnmarshalCompressed(&cBad) {
+			test.ReportError(t, true, false, cBad)
+		}
+		// x-coordinate such that x^3 - 3x + b is not a square.
+		x, rhs := big.NewInt(0), new(big.Int)
+		for {
+			rhs.Exp(x, big.NewInt(3), params.P)
+			rhs.Sub(rhs, new(big.Int).Mul(x, big.NewInt(3)))
+			rhs.Add(rhs, params.B).Mod(rhs, params.P)
+			if big.Jacobi(rhs, params.P) < 0 {
+				break
+			}
+			x.Add(x, big.NewInt(1))
+		}
+		cBad[0] = 0x02
+		fillBytes(cBad[1:], x)
+		if P.UnmarshalCompressed(&cBad) {
+			test.ReportError(t, true, false, cBad)
+		}
+		if X, _ := p384.UnmarshalCompressed(cBad[:]); X != nil {
+			test.ReportError(t, X, nil, cBad)
+		}
+	})
+}
+
+func BenchmarkGroup(b *testing.B) {
+	var P p384.Point
+	var k [p384.ScalarSize]byte
+	var enc [p384.UncompressedSize]byte
+	_, _ = rand.Read(k[:])
+	P.ScalarBaseMult(&k)
+	P.Marshal(&enc)
+
+	b.Run("ScalarMult", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.ScalarMult(&k, &P)
+		}
+	})
+	b.Run("ScalarBaseMult", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.ScalarBaseMult(&k)
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.Unmarshal(&enc)
+		}
+	})
+}
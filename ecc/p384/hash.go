@@ -0,0 +1,178 @@
+package p384
+
+import (
+	"crypto"
+	_ "crypto/sha512" // registers SHA-384 for crypto.SHA384.
+)
+
+// Suite identifiers of the hash-to-curve suites for P-384 (see RFC-9380,
+// Section 8.3).
+const (
+	// HashToCurveSuite is the random oracle suite used by HashToCurve.
+	HashToCurveSuite = "P384_XMD:SHA-384_SSWU_RO_"
+	// EncodeToCurveSuite is the nonuniform suite used by EncodeToCurve.
+	EncodeToCurveSuite = "P384_XMD:SHA-384_SSWU_NU_"
+)
+
+// sizeUniform is the length L in bytes of the uniform strings reduced into
+// a field element, that is ceil((384 + 192)/8), where 192 bits are added
+// for a security level of 192 bits.
+const sizeUniform = 72
+
+// HashToCurve sets P to the hash of msg using the suite
+// P384_XMD:SHA-384_SSWU_RO_ of RFC-9380, where dst is the domain separation
+// tag. The result is indistinguishable from a random point of the group.
+// Runs in time that only depends on the length of the inputs.
+func (P *Point) HashToCurve(msg, dst []byte) {
+	var u [2]fp384
+	var Q0, Q1 affinePoint
+	hashToField(u[:], msg, dst)
+	mapToCurve(&Q0, &u[0])
+	mapToCurve(&Q1, &u[1])
+	P.p = *Q0.toProjective()
+	P.p.completeAdd(&P.p, Q1.toProjective())
+	// Since the cofactor of P-384 is 1, clearing it is not necessary.
+}
+
+// EncodeToCurve sets P to the encoding of msg using the suite
+// P384_XMD:SHA-384_SSWU_NU_ of RFC-9380, where dst is the domain separation
+// tag. The result is not uniformly distributed in the group, so it must not
+// be used where a random oracle is required; use HashToCurve instead. Runs
+// in time that only depends on the length of the inputs.
+func (P *Point) EncodeToCurve(msg, dst []byte) {
+	var u [1]fp384
+	var Q affinePoint
+	hashToField(u[:], msg, dst)
+	mapToCurve(&Q, &u[0])
+	P.p = *Q.toProjective()
+}
+
+// hashToField fills u with field elements derived from msg and dst as in
+// RFC-9380, Section 5.2, using expand_message_xmd with SHA-384.
+func hashToField(u []fp384, msg, dst []byte) {
+	b := expandMessageXMD(crypto.SHA384, msg, dst, len(u)*sizeUniform)
+	for i := range u {
+		fp384SetUniform(&u[i], b[i*sizeUniform:(i+1)*sizeUniform])
+	}
+}
+
+// fp384SetUniform sets e to the Montgomery encoding of the big-endian
+// integer in b reduced modulo p, where b has sizeUniform bytes.
+func fp384SetUniform(e *fp384, b []byte) {
+	// The integer is split as hi*2^384 + lo, where hi has 192 bits.
+	var in [sizeFp]byte
+	var hiW, loW fp384Words64
+	copy(in[2*sizeFp-sizeUniform:], b[:sizeUniform-sizeFp])
+	decodeWords(&hiW, &in)
+	copy(in[:], b[sizeUniform-sizeFp:])
+	decodeWords(&loW, &in)
+	reduceWords(&loW, 0)
+
+	var hi, lo fp384
+	hi.fromWords(&hiW)
+	lo.fromWords(&loW)
+	montEncode(&hi, &hi)
+	fp384Mul(&hi, &hi, &r2) // hi*R*2^384 = hi*R^2
+	montEncode(&lo, &lo)
+	fp384Add(e, &hi, &lo)
+}
+
+// mapToCurve calculates P = map_to_curve_simple_swu(u) as in RFC-9380,
+// Section 6.6.2. Runs in constant time.
+func mapToCurve(P *affinePoint, u *fp384) {
+	var one, tv1, tv2, x1, x2, gx1, gx2, y1, y2 fp384
+	montEncode(&one, &fp384{1})
+	fp384Sqr(&tv1, u)
+	fp384Mul(&tv1, &tv1, &sswuZ) // Z*u^2
+	fp384Sqr(&tv2, &tv1)
+	fp384Add(&tv2, &tv2, &tv1) // Z^2*u^4 + Z*u^2
+	fp384Inv(&tv2, &tv2)       // inv0, as the inverse of zero is zero.
+	fp384Add(&x1, &tv2, &one)
+	fp384Mul(&x1, &x1, &sswuC1) // x1 = (-B/A)*(1 + tv2)
+	fp384Cmov(&x1, &sswuC2, fp384IsZero(&tv2))
+	curveRHS(&gx1, &x1)
+	fp384Mul(&x2, &tv1, &x1) // x2 = Z*u^2*x1
+	curveRHS(&gx2, &x2)
+
+	isSquare := fp384Sqrt(&y1, &gx1)
+	fp384Sqrt(&y2, &gx2)
+	P.x, P.y = x2, y2
+	fp384Cmov(&P.x, &x1, isSquare)
+	fp384Cmov(&P.y, &y1, isSquare)
+
+	fp384Neg(&y2, &P.y)
+	fp384Cmov(&P.y, &y2, sgn0(u)^sgn0(&P.y))
+}
+
+// sgn0 returns the parity of the field element x.
+func sgn0(x *fp384) int {
+	var t fp384
+	montDecode(&t, x)
+	return int(t[0] & 0x1)
+}
+
+// expandMessageXMD returns n pseudo-random bytes derived from msg and dst
+// as in RFC-9380, Section 5.3.1. It panics if n is larger than allowed by
+// the hash function h.
+func expandMessageXMD(h crypto.Hash, msg, dst []byte, n int) []byte {
+	H := h.New()
+	bLen := H.Size()
+	ell := (n + bLen - 1) / bLen
+	if ell > 255 || n > 0xffff {
+		panic("p384: requested output is too long")
+	}
+	if len(dst) > 255 {
+		H.Reset()
+		_, _ = H.Write([]byte("H2C-OVERSIZE-DST-"))
+		_, _ = H.Write(dst)
+		dst = H.Sum(nil)
+	}
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	H.Reset()
+	_, _ = H.Write(make([]byte, H.BlockSize()))
+	_, _ = H.Write(msg)
+	_, _ = H.Write([]byte{byte(n >> 8), byte(n), 0})
+	_, _ = H.Write(dstPrime)
+	b0 := H.Sum(nil)
+
+	out := make([]byte, 0, ell*bLen)
+	bi := make([]byte, bLen)
+	for i := 1; i <= ell; i++ {
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+		H.Reset()
+		_, _ = H.Write(bi)
+		_, _ = H.Write([]byte{byte(i)})
+		_, _ = H.Write(dstPrime)
+		bi = H.Sum(bi[:0])
+		out = append(out, bi...)
+	}
+	return out[:n]
+}
+
+var (
+	// sswuZ is the Montgomery encoding of Z = -12, the constant of the
+	// simplified SWU map for P-384.
+	sswuZ = fp384{
+		0xf3, 0xff, 0xff, 0xff, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0xf3, 0xff, 0xff, 0xff, 0xf2, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	}
+	// sswuC1 is the Montgomery encoding of -B/A = B/3.
+	sswuC1 = fp384{
+		0x44, 0x0f, 0x6b, 0x34, 0x7b, 0x2d, 0x5b, 0xad, 0x4e, 0x66, 0x19, 0x7e,
+		0x9d, 0xe4, 0x0d, 0xfd, 0x64, 0xab, 0x0a, 0xb3, 0x33, 0x60, 0xfb, 0x27,
+		0xf6, 0xd8, 0xdb, 0x86, 0x4f, 0x19, 0xbd, 0x4b, 0xdc, 0x0a, 0x56, 0x0a,
+		0xfc, 0xb5, 0x63, 0xe7, 0xfd, 0x3f, 0xc5, 0xca, 0xc3, 0x05, 0x58, 0x44,
+	}
+	// sswuC2 is the Montgomery encoding of B/(Z*A) = B/36.
+	sswuC2 = fp384{
+		0xf0, 0xeb, 0x08, 0xef, 0x1f, 0x99, 0xc7, 0xe3, 0xdb, 0x1d, 0x82, 0xca,
+		0x0c, 0xd3, 0x6b, 0x6a, 0x9d, 0xe3, 0x40, 0xa4, 0x59, 0x9d, 0xff, 0x2d,
+		0xbf, 0xfc, 0x3c, 0x4b, 0x71, 0x17, 0xa5, 0x5b, 0x92, 0x2b, 0x87, 0xab,
+		0x7f, 0xa4, 0x9d, 0x28, 0x55, 0xc5, 0xe5, 0x50, 0xd0, 0x55, 0x07, 0x5b,
+	}
+)
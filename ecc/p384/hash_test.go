@@ -0,0 +1,179 @@
+package p384
+
+import (
+	"bytes"
+	"crypto"
+	_ "crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/cloudflare/circl/internal/test"
+)
+
+func readJSON(t *testing.T, fileName string, v interface{}) {
+	jsonFile, err := os.Open(fileName)
+	if err != nil {
+		t.Fatalf("File %v can not be opened. Error: %v", fileName, err)
+	}
+	defer jsonFile.Close()
+	input, _ := ioutil.ReadAll(jsonFile)
+
+	err = json.Unmarshal(input, v)
+	if err != nil {
+		t.Fatalf("File %v can not be loaded. Error: %v", fileName, err)
+	}
+}
+
+func hexToInt(t *testing.T, s string) *big.Int {
+	x, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		t.Fatalf("bad test vector: %v", s)
+	}
+	return x
+}
+
+type vectorPoint struct{ X, Y string }
+
+func (v vectorPoint) check(t *testing.T, ap *affinePoint, id ...interface{}) {
+	gotX, gotY := ap.toInt()
+	wantX, wantY := hexToInt(t, v.X), hexToInt(t, v.Y)
+	if gotX.Cmp(wantX) != 0 || gotY.Cmp(wantY) != 0 {
+		test.ReportError(t, ap, v, id...)
+	}
+}
+
+// Test vectors from Appendix J.3 of RFC-9380.
+func TestHashToCurve(t *testing.T) {
+	for _, v := range []struct {
+		fileName, suite string
+		count           int
+	}{
+		{"testdata/P384_XMD-SHA-384_SSWU_RO_.json", HashToCurveSuite, 2},
+		{"testdata/P384_XMD-SHA-384_SSWU_NU_.json", EncodeToCurveSuite, 1},
+	} {
+		var kat struct {
+			Dst          string `json:"dst"`
+			Suite        string `json:"ciphersuite"`
+			RandomOracle bool   `json:"randomOracle"`
+			Vectors      []struct {
+				P, Q, Q0, Q1 vectorPoint
+				Msg          string `json:"msg"`
+				U            []string
+			} `json:"vectors"`
+		}
+		readJSON(t, v.fileName, &kat)
+		if kat.Suite != v.suite || kat.RandomOracle != (v.count == 2) {
+			t.Fatalf("unexpected suite %v", kat.Suite)
+		}
+		dst := []byte(kat.Dst)
+		for i, vi := range kat.Vectors {
+			msg := []byte(vi.Msg)
+			u := make([]fp384, v.count)
+			hashToField(u, msg, dst)
+			for j := range u {
+				var want fp384
+				want.SetBigInt(hexToInt(t, vi.U[j]))
+				montEncode(&want, &want)
+				if u[j] != want {
+					test.ReportError(t, u[j], want, v.suite, i, j)
+				}
+			}
+
+			var Q affinePoint
+			var P Point
+			if v.count == 2 {
+				mapToCurve(&Q, &u[0])
+				vi.Q0.check(t, &Q, v.suite, i)
+				mapToCurve(&Q, &u[1])
+				vi.Q1.check(t, &Q, v.suite, i)
+				P.HashToCurve(msg, dst)
+			} else {
+				mapToCurve(&Q, &u[0])
+				vi.Q.check(t, &Q, v.suite, i)
+				P.EncodeToCurve(msg, dst)
+			}
+			if !P.IsOnCurve() {
+				test.ReportError(t, P.IsOnCurve(), true, v.suite, i)
+			}
+			vi.P.check(t, P.p.toAffine(), v.suite, i)
+		}
+	}
+}
+
+// Test vectors from Appendix K of RFC-9380.
+func TestExpandMessageXMD(t *testing.T) {
+	for _, v := range []struct {
+		fileName string
+		h        crypto.Hash
+	}{
+		{"testdata/expand_message_xmd_SHA256_38.json", crypto.SHA256},
+		{"testdata/expand_message_xmd_SHA256_256.json", crypto.SHA256},
+		{"testdata/expand_message_xmd_SHA512_38.json", crypto.SHA512},
+	} {
+		var kat struct {
+			DST   string `json:"DST"`
+			Tests []struct {
+				LenInBytes   string `json:"len_in_bytes"`
+				Msg          string `json:"msg"`
+				UniformBytes string `json:"uniform_bytes"`
+			} `json:"tests"`
+		}
+		readJSON(t, v.fileName, &kat)
+		for i, vi := range kat.Tests {
+			n, err := strconv.ParseUint(vi.LenInBytes, 0, 16)
+			test.CheckNoErr(t, err, "bad length")
+			want, err := hex.DecodeString(vi.UniformBytes)
+			test.CheckNoErr(t, err, "bad output")
+
+			got := expandMessageXMD(v.h, []byte(vi.Msg), []byte(kat.DST), int(n))
+			if !bytes.Equal(got, want) {
+				test.ReportError(t, got, want, v.fileName, i)
+			}
+		}
+	}
+}
+
+func TestMapToCurve(t *testing.T) {
+	const testTimes = 1 << 8
+	var u fp384
+	var P affinePoint
+	var b [sizeUniform]byte
+	t.Run("zero", func(t *testing.T) {
+		// u=0 is the exceptional case of the map.
+		mapToCurve(&P, &u)
+		if !P.isOnCurve() {
+			test.ReportError(t, P.isOnCurve(), true, u)
+		}
+	})
+	t.Run("random", func(t *testing.T) {
+		for i := 0; i < testTimes; i++ {
+			copy(b[:], expandMessageXMD(crypto.SHA384, []byte{byte(i)}, nil, sizeUniform))
+			fp384SetUniform(&u, b[:])
+			mapToCurve(&P, &u)
+			if !P.isOnCurve() {
+				test.ReportError(t, P.isOnCurve(), true, u)
+			}
+		}
+	})
+}
+
+func BenchmarkHashToCurve(b *testing.B) {
+	var P Point
+	msg := []byte("message")
+	dst := []byte("QUUX-V01-CS02-with-" + HashToCurveSuite)
+	b.Run("HashToCurve", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.HashToCurve(msg, dst)
+		}
+	})
+	b.Run("EncodeToCurve", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.EncodeToCurve(msg, dst)
+		}
+	})
+}
@@ -1,10 +1,7 @@
-// +build arm64 amd64
-
 package p384
 
 import (
 	"crypto/elliptic"
-	"crypto/subtle"
 	"math/big"
 
 	"github.com/cloudflare/circl/math"
@@ -39,25 +36,7 @@ func (c curve) IsAtInfinity(x, y *big.Int) bool {
 
 // IsOnCurve reports whether the given (x,y) lies on the curve.
 func (c curve) IsOnCurve(x, y *big.Int) bool {
-	x1, y1 := &fp384{}, &fp384{}
-	x1.SetBigInt(x)
-	y1.SetBigInt(y)
-	montEncode(x1, x1)
-	montEncode(y1, y1)
-
-	y2, x3 := &fp384{}, &fp384{}
-	fp384Sqr(y2, y1)
-	fp384Sqr(x3, x1)
-	fp384Mul(x3, x3, x1)
-
-	threeX := &fp384{}
-	fp384Add(threeX, x1, x1)
-	fp384Add(threeX, threeX, x1)
-
-	fp384Sub(x3, x3, threeX)
-	fp384Add(x3, x3, &bb)
-
-	return *y2 == *x3
+	return newAffinePoint(x, y).isOnCurve()
 }
 
 // Add returns the sum of (x1,y1) and (x2,y2)
@@ -74,82 +53,14 @@ func (c curve) Double(x1, y1 *big.Int) (x, y *big.Int) {
 	return P.toAffine().toInt()
 }
 
-// reduceScalar shorten a scalar modulo the order of the curve.
-func (c curve) reduceScalar(k []byte) []byte {
-	const max = sizeFp
-	if len(k) > max {
-		bigK := new(big.Int).SetBytes(k)
-		bigK.Mod(bigK, c.Params().N)
-		k = bigK.Bytes()
-	}
-	if len(k) < max {
-		k = append(make([]byte, max-len(k)), k...)
-	}
-	return k
-}
-
-// toOdd performs k = (-k mod N) if k is even.
-func (c curve) toOdd(k []byte) ([]byte, int) {
-	var X, Y big.Int
-	X.SetBytes(k)
-	Y.Neg(&X).Mod(&Y, c.Params().N)
-	isEven := 1 - int(X.Bit(0))
-	x := X.Bytes()
-	y := Y.Bytes()
-
-	if len(x) < len(y) {
-		x = append(make([]byte, len(y)-len(x)), x...)
-	} else if len(x) > len(y) {
-		y = append(make([]byte, len(x)-len(y)), y...)
-	}
-	subtle.ConstantTimeCopy(isEven, x, y)
-	return x, isEven
-}
-
 // ScalarMult returns (Qx,Qy)=k*(Px,Py) where k is a number in big-endian form.
 func (c curve) ScalarMult(x1, y1 *big.Int, k []byte) (x, y *big.Int) {
-	return c.scalarMultOmega(x1, y1, k, 5)
-}
-
-func (c curve) scalarMultOmega(x1, y1 *big.Int, k []byte, omega uint) (x, y *big.Int) {
-	k = c.reduceScalar(k)
-	oddK, isEvenK := c.toOdd(k)
-
-	var scalar big.Int
-	scalar.SetBytes(oddK)
-	if scalar.Sign() == 0 {
-		return new(big.Int), new(big.Int)
-	}
-	const bitsN = uint(384)
-	L := math.SignedDigit(&scalar, omega, bitsN)
-
-	var R jacobianPoint
-	Q := zeroPoint().toJacobian()
-	TabP := newAffinePoint(x1, y1).oddMultiples(omega)
-	for i := len(L) - 1; i > 0; i-- {
-		for j := uint(0); j < omega-1; j++ {
-			Q.double()
-		}
-		idx := absolute(L[i]) >> 1
-		for j := range TabP {
-			R.cmov(&TabP[j], subtle.ConstantTimeEq(int32(j), idx))
-		}
-		R.cneg(int(L[i]>>31) & 1)
-		Q.add(Q, &R)
-	}
-	// Calculate the last iteration using complete addition formula.
-	for j := uint(0); j < omega-1; j++ {
-		Q.double()
-	}
-	idx := absolute(L[0]) >> 1
-	for j := range TabP {
-		R.cmov(&TabP[j], subtle.ConstantTimeEq(int32(j), idx))
-	}
-	R.cneg(int(L[0]>>31) & 1)
-	QQ := Q.toProjective()
-	QQ.completeAdd(QQ, R.toProjective())
-	QQ.cneg(isEvenK)
-	return QQ.toAffine().toInt()
+	var s Scalar
+	var P Point
+	s.SetBytes(k)
+	P.p = *newAffinePoint(x1, y1).toProjective()
+	P.scalarMultOmega(&s, &P, 5)
+	return P.p.toAffine().toInt()
 }
 
 // ScalarBaseMult returns k*G, where G is the base point of the group
@@ -208,3 +119,29 @@ func absolute(x int32) int32 {
 	mask := x >> 31
 	return (x + mask) ^ mask
 }
+
+// MarshalCompressed converts the point (x,y) of the curve into the SEC 1
+// compressed form.
+func MarshalCompressed(x, y *big.Int) []byte {
+	var P Point
+	var out [CompressedSize]byte
+	P.p = *newAffinePoint(x, y).toProjective()
+	P.MarshalCompressed(&out)
+	return out[:]
+}
+
+// UnmarshalCompressed converts a point in SEC 1 compressed form into the
+// coordinates (x,y). It returns x = nil if data is not a valid compressed
+// encoding of a point of the curve.
+func UnmarshalCompressed(data []byte) (x, y *big.Int) {
+	var P Point
+	var in [CompressedSize]byte
+	if len(data) != CompressedSize {
+		return nil, nil
+	}
+	copy(in[:], data)
+	if !P.UnmarshalCompressed(&in) {
+		return nil, nil
+	}
+	return P.p.toAffine().toInt()
+}
@@ -1,5 +1,3 @@
-// +build arm64 amd64
-
 package p384
 
 import (
@@ -81,22 +79,24 @@ func TestScalarMult(t *testing.T) {
 	StdCurve := elliptic.P384()
 	params := StdCurve.Params()
 
-	t.Run("toOdd", func(t *testing.T) {
-		var c curve
-		k := []byte{0xF0}
-		oddK, _ := c.toOdd(k)
-		got := len(oddK)
-		want := 48
-		if got != want {
-			test.ReportError(t, got, want)
-		}
-
-		oddK[sizeFp-1] = 0x0
-		smallOddK, _ := c.toOdd(oddK)
-		got = len(smallOddK)
-		want = 48
-		if got != want {
-			test.ReportError(t, got, want)
+	t.Run("signedDigits", func(t *testing.T) {
+		for _, w := range []uint{2, 5, 7} {
+			for i := 0; i < testTimes; i++ {
+				K, _ := rand.Int(rand.Reader, params.N)
+				K.SetBit(K, 0, 1)
+				var s Scalar
+				s.SetBytes(K.Bytes())
+
+				got := new(big.Int)
+				L := s.signedDigits(w)
+				for j := len(L) - 1; j >= 0; j-- {
+					got.Lsh(got, w-1)
+					got.Add(got, big.NewInt(int64(L[j])))
+				}
+				if got.Cmp(K) != 0 {
+					test.ReportError(t, got, K, w)
+				}
+			}
 		}
 	})
 
@@ -126,11 +126,15 @@ func TestScalarMult(t *testing.T) {
 			{w: 19, k: 152294},
 		}
 
-		var c curve
+		var G, P Point
+		var s Scalar
+		G.SetGenerator()
 
 		for _, caseI := range cases {
 			k := big.NewInt(int64(caseI.k)).Bytes()
-			gotX, gotY := c.scalarMultOmega(params.Gx, params.Gy, k, caseI.w)
+			s.SetUint64(uint64(caseI.k))
+			P.scalarMultOmega(&s, &G, caseI.w)
+			gotX, gotY := P.p.toAffine().toInt()
 			wantX, wantY := StdCurve.ScalarMult(params.Gx, params.Gy, k)
 
 			if gotX.Cmp(wantX) != 0 {
@@ -164,7 +168,7 @@ func TestScalarMult(t *testing.T) {
 			y, _ := rand.Int(rand.Reader, params.P)
 
 			got := CirclCurve.IsOnCurve(CirclCurve.ScalarMult(x, y, k.Bytes()))
-			want := StdCurve.IsOnCurve(StdCurve.ScalarMult(x, y, k.Bytes()))
+			want := stdIsOnCurveAfterScalarMult(StdCurve, x, y, k.Bytes())
 
 			if got != want {
 				test.ReportError(t, got, want, k, x, y)
@@ -173,6 +177,19 @@ func TestScalarMult(t *testing.T) {
 	})
 }
 
+// stdIsOnCurveAfterScalarMult returns whether the result of the standard
+// library's ScalarMult is on the curve. Recent versions of crypto/elliptic
+// panic on invalid input points instead of returning a point off the curve,
+// in which case it returns false.
+func stdIsOnCurveAfterScalarMult(c elliptic.Curve, x, y *big.Int, k []byte) (ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return c.IsOnCurve(c.ScalarMult(x, y, k))
+}
+
 func TestScalarBaseMult(t *testing.T) {
 	const testTimes = 1 << 7
 	CirclCurve := P384()
@@ -264,6 +281,25 @@ func TestCombinedMult(t *testing.T) {
 	}
 }
 
+func TestCombinedMultDoubling(t *testing.T) {
+	// Using Q=G and m=n, the point additions of CombinedMult are doublings.
+	const testTimes = 1 << 5
+	CirclCurve := P384()
+	StdCurve := elliptic.P384()
+	params := StdCurve.Params()
+
+	for i := 0; i < testTimes; i++ {
+		K, _ := rand.Int(rand.Reader, params.N)
+		k := K.Bytes()
+		wantX, wantY := StdCurve.Double(StdCurve.ScalarBaseMult(k))
+
+		gotX, gotY := CirclCurve.CombinedMult(params.Gx, params.Gy, k, k)
+		if gotX.Cmp(wantX) != 0 || gotY.Cmp(wantY) != 0 {
+			test.ReportError(t, gotX, wantX, K)
+		}
+	}
+}
+
 func TestAbsoute(t *testing.T) {
 	cases := []int32{-2, -1, 0, 1, 2}
 	expected := []int32{2, 1, 0, 1, 2}
@@ -1,5 +1,3 @@
-// +build arm64 amd64
-
 package p384
 
 import (
@@ -34,6 +32,30 @@ func (ap *affinePoint) isZero() bool {
 	return ap.x == zero && ap.y == zero
 }
 
+// isOnCurve reports whether ap satisfies the curve equation. Note that the
+// point at infinity does not satisfy it.
+func (ap *affinePoint) isOnCurve() bool {
+	y2, x3 := &fp384{}, &fp384{}
+	fp384Sqr(y2, &ap.y)
+	curveRHS(x3, &ap.x)
+	return *y2 == *x3
+}
+
+// curveRHS calculates z = x^3 - 3x + b, the right-hand side of the curve
+// equation.
+func curveRHS(z, x *fp384) {
+	x3 := &fp384{}
+	fp384Sqr(x3, x)
+	fp384Mul(x3, x3, x)
+
+	threeX := &fp384{}
+	fp384Add(threeX, x, x)
+	fp384Add(threeX, threeX, x)
+
+	fp384Sub(x3, x3, threeX)
+	fp384Add(z, x3, &bb)
+}
+
 func (ap *affinePoint) neg() { fp384Neg(&ap.y, &ap.y) }
 
 func (ap *affinePoint) toInt() (x, y *big.Int) {
@@ -139,8 +161,8 @@ func (P *jacobianPoint) toAffine() *affinePoint {
 
 func (P *jacobianPoint) cmov(Q *jacobianPoint, b int) { P.p2Point.cmov(&Q.p2Point, b) }
 
-// add calculates P=Q+R such that Q and R are different than the identity point,
-// and Q!==R. This function cannot be used for doublings.
+// add calculates P=Q+R. The cases Q=R and Q=-R are handled in non-constant
+// time, as they only occur for exceptional inputs.
 func (P *jacobianPoint) add(Q, R *jacobianPoint) {
 	if Q.isZero() {
 		*P = *R
@@ -170,6 +192,15 @@ func (P *jacobianPoint) add(Q, R *jacobianPoint) {
 	fp384Sqr(HH, H)        // HH = H ^ 2
 	fp384Mul(HHH, H, HH)   // HHH = H * HH
 	fp384Sub(RR, S2, S1)   // r = S2 - S1
+	if *H == (fp384{}) {
+		if *RR == (fp384{}) {
+			*P = *Q
+			P.double()
+		} else {
+			*P = *(zeroPoint().toJacobian())
+		}
+		return
+	}
 	fp384Mul(V, U1, HH)    // V = U1 * HH
 	fp384Sqr(t2, RR)       // t2 = r ^ 2
 	fp384Add(t3, V, V)     // t3 = V + V
@@ -1,5 +1,3 @@
-// +build arm64 amd64
-
 package p384
 
 import (
@@ -117,13 +115,12 @@ func TestPointAdd(t *testing.T) {
 	})
 
 	t.Run("P+P=2P", func(t *testing.T) {
-		// This verifies that add function cannot be used for doublings.
 		for i := 0; i < 128; i++ {
 			P = randomJacobian()
 
 			R.add(P, P)
 			gotX, gotY := R.toAffine().toInt()
-			wantX, wantY := zeroPoint().toInt()
+			wantX, wantY := StdCurve.Double(P.toAffine().toInt())
 
 			if gotX.Cmp(wantX) != 0 {
 				test.ReportError(t, gotX, wantX, P)
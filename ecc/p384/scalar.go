@@ -0,0 +1,220 @@
+package p384
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"io"
+	"math/bits"
+)
+
+// ScalarSize is the length in bytes of an encoded Scalar.
+const ScalarSize = sizeFp
+
+// Scalar represents an integer modulo the order N of the P-384 group. The
+// zero value is the integer zero.
+type Scalar struct{ k fp384Words64 }
+
+// nWords is the order N of the group, represented as little-endian 64-bit
+// words.
+var nWords = fp384Words64{
+	0xecec196accc52973, 0x581a0db248b0a77a, 0xc7634d81f4372ddf,
+	0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff,
+}
+
+// nMinusTwo is N-2, used as exponent for inversion.
+var nMinusTwo = fp384Words64{
+	0xecec196accc52971, 0x581a0db248b0a77a, 0xc7634d81f4372ddf,
+	0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff,
+}
+
+// rrN is R^2 mod N, where R = 2^384.
+var rrN = fp384Words64{
+	0x2d319b2419b409a9, 0xff3d81e5df1aa419, 0xbc3e483afcb82947,
+	0xd40d49174aab1cc5, 0x3fb05b7a28266895, 0x0c84ee012b39bf21,
+}
+
+// nPrime is -1/N mod 2^64.
+const nPrime = 0x6ed46089e88fdc45
+
+// SetUint64 sets s = x.
+func (s *Scalar) SetUint64(x uint64) { s.k = fp384Words64{x} }
+
+// SetBytes sets s to the big-endian integer b reduced modulo N. It runs in
+// time that depends only on the length of b.
+func (s *Scalar) SetBytes(b []byte) {
+	var c fp384Words64
+	s.k = fp384Words64{}
+	for len(b) > 0 {
+		n := len(b) % ScalarSize
+		if n == 0 {
+			n = ScalarSize
+		}
+		var chunk [ScalarSize]byte
+		copy(chunk[ScalarSize-n:], b[:n])
+		b = b[n:]
+
+		// s = s*2^(8n) + chunk, where s*2^384 = s*R^2/R mod N.
+		decodeWords(&c, &chunk)
+		modReduceWords(&c, 0, &nWords)
+		if n == ScalarSize {
+			montMulWords(&s.k, &s.k, &rrN, &nWords, nPrime)
+		} else {
+			var shift fp384Words64
+			shift[n/8] = 1 << (8 * uint(n%8))
+			montMulWords(&shift, &shift, &rrN, &nWords, nPrime)
+			montMulWords(&s.k, &s.k, &shift, &nWords, nPrime)
+		}
+		s.add(&c)
+	}
+}
+
+// Random sets s to a uniformly random scalar using rnd as source of
+// randomness.
+func (s *Scalar) Random(rnd io.Reader) error {
+	var b [ScalarSize + 16]byte
+	if _, err := io.ReadFull(rnd, b[:]); err != nil {
+		return err
+	}
+	s.SetBytes(b[:])
+	return nil
+}
+
+// IsZero returns true if s is zero. Runs in constant time.
+func (s *Scalar) IsZero() bool { return s.IsEqual(&Scalar{}) }
+
+// IsEqual returns true if s and t are equal. Runs in constant time.
+func (s *Scalar) IsEqual(t *Scalar) bool {
+	var diff uint64
+	for i := range s.k {
+		diff |= s.k[i] ^ t.k[i]
+	}
+	return subtle.ConstantTimeEq(int32((diff>>32)|(diff&0xffffffff)), 0) == 1
+}
+
+// Add calculates s = x + y mod N.
+func (s *Scalar) Add(x, y *Scalar) {
+	*s = *x
+	s.add(&y.k)
+}
+
+func (s *Scalar) add(y *fp384Words64) {
+	var carry uint64
+	for i := range s.k {
+		s.k[i], carry = bits.Add64(s.k[i], y[i], carry)
+	}
+	modReduceWords(&s.k, carry, &nWords)
+}
+
+// Neg calculates s = -x mod N.
+func (s *Scalar) Neg(x *Scalar) {
+	var borrow uint64
+	for i := range s.k {
+		s.k[i], borrow = bits.Sub64(nWords[i], x.k[i], borrow)
+	}
+	modReduceWords(&s.k, 0, &nWords) // if x = 0, then N is reduced to 0.
+}
+
+// Sub calculates s = x - y mod N.
+func (s *Scalar) Sub(x, y *Scalar) {
+	var t Scalar
+	t.Neg(y)
+	s.Add(x, &t)
+}
+
+// Mul calculates s = x * y mod N.
+func (s *Scalar) Mul(x, y *Scalar) {
+	montMulWords(&s.k, &x.k, &y.k, &nWords, nPrime)
+	montMulWords(&s.k, &s.k, &rrN, &nWords, nPrime)
+}
+
+// Inv calculates s = 1/x mod N, using Fermat's little theorem. If x is zero,
+// s is set to zero. Runs in constant time.
+func (s *Scalar) Inv(x *Scalar) {
+	// Exponentiation is performed in the Montgomery domain.
+	var xR, z fp384Words64
+	montMulWords(&xR, &x.k, &rrN, &nWords, nPrime)
+	montMulWords(&z, &fp384Words64{1}, &rrN, &nWords, nPrime)
+	for i := ScalarSize*8 - 1; i >= 0; i-- {
+		montMulWords(&z, &z, &z, &nWords, nPrime)
+		if (nMinusTwo[i/64]>>uint(i%64))&1 == 1 {
+			montMulWords(&z, &z, &xR, &nWords, nPrime)
+		}
+	}
+	montMulWords(&s.k, &z, &fp384Words64{1}, &nWords, nPrime)
+}
+
+// Marshal encodes s as a big-endian integer of ScalarSize bytes.
+func (s *Scalar) Marshal(out *[ScalarSize]byte) { encodeWords(out, &s.k) }
+
+// Unmarshal sets s from a big-endian integer of ScalarSize bytes. It returns
+// false if the integer is not less than N, in which case s is not modified.
+func (s *Scalar) Unmarshal(in *[ScalarSize]byte) bool {
+	var k fp384Words64
+	decodeWords(&k, in)
+	var borrow uint64
+	for i := range k {
+		_, borrow = bits.Sub64(k[i], nWords[i], borrow)
+	}
+	if borrow == 0 {
+		return false
+	}
+	s.k = k
+	return true
+}
+
+// cmov sets s to t if b=1.
+func (s *Scalar) cmov(t *Scalar, b int) {
+	mask := -uint64(b & 0x1)
+	for i := range s.k {
+		s.k[i] = (s.k[i] &^ mask) | (t.k[i] & mask)
+	}
+}
+
+// isOdd returns 1 if s is odd, and 0 otherwise.
+func (s *Scalar) isOdd() int { return int(s.k[0] & 1) }
+
+// signedDigits recodes an odd scalar s into digits L such that
+// s = sum L[i]*2^(i*(w-1)), where each L[i] is odd and |L[i]| < 2^(w-1).
+// The number of digits only depends on w. Runs in constant time.
+func (s *Scalar) signedDigits(w uint) []int32 {
+	const l = ScalarSize * 8
+	lenN := (l + (w - 1) - 1) / (w - 1) // ceil(l/(w-1))
+	L := make([]int32, lenN+1)
+	var k [fp384Words + 1]uint64
+	copy(k[:], s.k[:])
+
+	mask := uint64(1)<<w - 1
+	for i := uint(0); i < lenN; i++ {
+		value := int64(k[0]&mask) - int64(1)<<(w-1)
+		L[i] = int32(value)
+
+		// k = (k - value) >> (w-1)
+		v := uint64(value)
+		ext := uint64(value >> 63)
+		var borrow uint64
+		k[0], borrow = bits.Sub64(k[0], v, 0)
+		for j := 1; j < len(k); j++ {
+			k[j], borrow = bits.Sub64(k[j], ext, borrow)
+		}
+		for j := 0; j < len(k)-1; j++ {
+			k[j] = (k[j] >> (w - 1)) | (k[j+1] << (64 - (w - 1)))
+		}
+		k[len(k)-1] >>= w - 1
+	}
+	L[lenN] = int32(k[0])
+	return L
+}
+
+// decodeWords converts a big-endian integer into little-endian words.
+func decodeWords(z *fp384Words64, in *[ScalarSize]byte) {
+	for i := range z {
+		z[i] = binary.BigEndian.Uint64(in[ScalarSize-8*(i+1):])
+	}
+}
+
+// encodeWords converts little-endian words into a big-endian integer.
+func encodeWords(out *[ScalarSize]byte, z *fp384Words64) {
+	for i := range z {
+		binary.BigEndian.PutUint64(out[ScalarSize-8*(i+1):], z[i])
+	}
+}
@@ -0,0 +1,250 @@
+package decaf448
+
+import (
+	"crypto/subtle"
+
+	"github.com/cloudflare/circl/group/edwards448"
+	fp "github.com/cloudflare/circl/math/fp448"
+)
+
+// Size is the length in bytes of the encodings of elements and scalars.
+const Size = 56
+
+// UniformSize is the length in bytes of the input of FromUniformBytes.
+const UniformSize = 2 * Size
+
+// Scalar is an integer modulo the order of the group. Scalars are encoded
+// and decoded as 56-byte integers in little-endian order.
+type Scalar = edwards448.Scalar
+
+// Element is an element of the decaf448 group. The zero value is not a
+// valid element; use SetIdentity, SetGenerator, Unmarshal, or
+// FromUniformBytes to initialize it.
+type Element struct{ p edwards448.Point }
+
+var (
+	// paramD is the parameter d = -39081 of Edwards448.
+	paramD = fp.Elt{
+		0x56, 0x67, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xfe, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	}
+	// oneMinusD is 1-d = 39082.
+	oneMinusD = fp.Elt{0xaa, 0x98}
+	// oneMinusTwoD is 1-2d = 78163.
+	oneMinusTwoD = fp.Elt{0x53, 0x31, 0x01}
+	// sqrtMinusD is sqrt(-d).
+	sqrtMinusD = fp.Elt{
+		0x36, 0x27, 0x57, 0x45, 0x0f, 0xef, 0x42, 0x96,
+		0x52, 0xce, 0x20, 0xaa, 0xf6, 0x7b, 0x33, 0x60,
+		0xd2, 0xde, 0x6e, 0xfd, 0xf4, 0x66, 0x9a, 0x83,
+		0xba, 0x14, 0x8c, 0x96, 0x80, 0xd7, 0xa2, 0x64,
+		0x4b, 0xd5, 0xb8, 0xa5, 0xb8, 0xa7, 0xf1, 0xa1,
+		0xa0, 0x6a, 0xa2, 0x2f, 0x72, 0x8d, 0xf6, 0x3b,
+		0x68, 0xf7, 0x24, 0xeb, 0xfb, 0x62, 0xd9, 0x22,
+	}
+	// invSqrtMinusD is 1/sqrt(-d).
+	invSqrtMinusD = fp.Elt{
+		0x2c, 0x68, 0x78, 0xb8, 0x5e, 0xbb, 0xaf, 0x53,
+		0xf3, 0x94, 0x9e, 0xf1, 0x79, 0x24, 0xbb, 0xef,
+		0x15, 0xba, 0x1f, 0xc2, 0xe2, 0x7e, 0x70, 0xbe,
+		0x1a, 0x52, 0xa6, 0x28, 0xf1, 0x56, 0xba, 0xd6,
+		0xa7, 0x27, 0x5b, 0x3a, 0x0c, 0x95, 0x90, 0x5a,
+		0x07, 0xc8, 0xca, 0x0b, 0x5a, 0xe3, 0x2b, 0x90,
+		0x57, 0xc0, 0x22, 0xe2, 0x52, 0x06, 0xf4, 0x6e,
+	}
+)
+
+// SetIdentity sets e to the identity element.
+func (e *Element) SetIdentity() { e.p.SetIdentity() }
+
+// SetGenerator sets e to the canonical generator of the group, which is
+// represented by twice the generator of Edwards448.
+func (e *Element) SetGenerator() {
+	e.p.SetGenerator()
+	e.p.Double(&e.p)
+}
+
+// IsIdentity returns true if e is the identity element.
+func (e *Element) IsIdentity() bool {
+	x, _, _, _ := e.p.ExtendedCoordinates()
+	return fp.IsZero(&x)
+}
+
+// IsEqual returns true if e and f represent the same element.
+func (e *Element) IsEqual(f *Element) bool {
+	x1, y1, _, _ := e.p.ExtendedCoordinates()
+	x2, y2, _, _ := f.p.ExtendedCoordinates()
+	l, r := &fp.Elt{}, &fp.Elt{}
+	// Checks that x1*y2 = y1*x2.
+	fp.Mul(l, &x1, &y2)
+	fp.Mul(r, &y1, &x2)
+	return fp.IsEqual(l, r) == 1
+}
+
+// Add calculates e = f + g.
+func (e *Element) Add(f, g *Element) { e.p.Add(&f.p, &g.p) }
+
+// Sub calculates e = f - g.
+func (e *Element) Sub(f, g *Element) { e.p.Sub(&f.p, &g.p) }
+
+// Neg calculates e = -f.
+func (e *Element) Neg(f *Element) { e.p.Neg(&f.p) }
+
+// Double calculates e = 2f.
+func (e *Element) Double(f *Element) { e.p.Double(&f.p) }
+
+// ScalarMult calculates e = kf.
+func (e *Element) ScalarMult(k *Scalar, f *Element) { e.p.ScalarMult(k, &f.p) }
+
+// ScalarBaseMult calculates e = kG, where G is the generator of the group.
+func (e *Element) ScalarBaseMult(k *Scalar) {
+	e.p.ScalarBaseMult(k)
+	e.p.Double(&e.p)
+}
+
+// Marshal stores the canonical encoding of e in out.
+func (e *Element) Marshal(out *[Size]byte) {
+	x0, _, z0, t0 := e.p.ExtendedCoordinates()
+	u1, u2, t := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+	one, invSqrt, ratio := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+
+	fp.Add(u1, &x0, &t0)
+	fp.Sub(t, &x0, &t0)
+	fp.Mul(u1, u1, t) // u1 = (x0+t0)*(x0-t0)
+	fp.Sqr(t, &x0)
+	fp.Mul(t, t, &oneMinusD)
+	fp.Mul(t, t, u1) // t = u1*(1-d)*x0^2
+	fp.SetOne(one)
+	fp.SqrtRatioM1(invSqrt, one, t)
+	fp.Mul(ratio, invSqrt, u1)
+	fp.Mul(ratio, ratio, &sqrtMinusD)
+	cneg(ratio, fp.IsNegative(ratio)) // ratio = |invsqrt*u1*sqrt(-d)|
+	fp.Mul(u2, &invSqrtMinusD, ratio)
+	fp.Mul(u2, u2, &z0)
+	fp.Sub(u2, u2, &t0) // u2 = invsqrt(-d)*ratio*z0 - t0
+	fp.Mul(t, &oneMinusD, invSqrt)
+	fp.Mul(t, t, &x0)
+	fp.Mul(t, t, u2)          // s = (1-d)*invsqrt*x0*u2
+	cneg(t, fp.IsNegative(t)) // s = |s|
+	fp.ToBytes(out[:], t)
+}
+
+// Unmarshal sets e to the element encoded in the input, and returns false
+// if it is not the canonical encoding of an element. In that case, e is
+// not modified.
+func (e *Element) Unmarshal(in *[Size]byte) bool {
+	s := &fp.Elt{}
+	copy(s[:], in[:])
+	fp.Modp(s)
+	// s must be canonically encoded and non-negative.
+	isCanonical := uint(subtle.ConstantTimeCompare(s[:], in[:])) & (1 - fp.IsNegative(s))
+
+	one, ss, u1, u2, t := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+	fp.SetOne(one)
+	fp.Sqr(ss, s)       // ss = s^2
+	fp.Add(u1, one, ss) // u1 = 1+s^2
+	fp.Mul(t, ss, &paramD)
+	fp.Add(t, t, t)
+	fp.Add(t, t, t)
+	fp.Sqr(u2, u1)
+	fp.Sub(u2, u2, t) // u2 = u1^2 - 4*d*s^2
+
+	invSqrt, u3 := &fp.Elt{}, &fp.Elt{}
+	fp.Sqr(t, u1)
+	fp.Mul(t, t, u2)
+	isQR := fp.SqrtRatioM1(invSqrt, one, t)
+
+	var x, y, z, xy fp.Elt
+	fp.Add(u3, s, s)
+	fp.Mul(u3, u3, invSqrt)
+	fp.Mul(u3, u3, u1)
+	fp.Mul(u3, u3, &sqrtMinusD)
+	cneg(u3, fp.IsNegative(u3)) // u3 = |2*s*invsqrt*u1*sqrt(-d)|
+	fp.Mul(&x, u3, invSqrt)
+	fp.Mul(&x, &x, u2)
+	fp.Mul(&x, &x, &invSqrtMinusD) // x = u3*invsqrt*u2*invsqrt(-d)
+	fp.Sub(&y, one, ss)
+	fp.Mul(&y, &y, invSqrt)
+	fp.Mul(&y, &y, u1)  // y = (1-s^2)*invsqrt*u1
+	fp.Mul(&xy, &x, &y) // t = x*y
+	fp.SetOne(&z)
+
+	// Decoding fails if u1^2*u2 is not a square.
+	if isCanonical&isQR == 0 {
+		return false
+	}
+	return e.p.SetExtendedCoordinates(&x, &y, &z, &xy)
+}
+
+// FromUniformBytes sets e to the element obtained by mapping 112 uniformly
+// random bytes to the group, such as the output of a hash function. The
+// resulting element has a distribution indistinguishable from uniform.
+func (e *Element) FromUniformBytes(in *[UniformSize]byte) {
+	var r0, r1 fp.Elt
+	copy(r0[:], in[:Size])
+	copy(r1[:], in[Size:])
+	fp.Modp(&r0)
+	fp.Modp(&r1)
+
+	var P, Q edwards448.Point
+	elligator(&P, &r0)
+	elligator(&Q, &r1)
+	e.p.Add(&P, &Q)
+}
+
+// elligator maps a field element to a point, as specified by the MAP
+// function of RFC-9496.
+func elligator(P *edwards448.Point, t *fp.Elt) {
+	one, r, u0, u1, v, vPrime, sgn, s := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+	fp.SetOne(one)
+	fp.Sqr(r, t)
+	fp.Neg(r, r) // r = -t^2
+	fp.Sub(u0, r, one)
+	fp.Mul(u0, u0, &paramD) // u0 = d*(r-1)
+	fp.Add(u1, u0, one)
+	fp.Sub(v, u0, r)
+	fp.Mul(u1, u1, v) // u1 = (u0+1)*(u0-r)
+	fp.Add(v, r, one)
+	fp.Mul(v, v, u1) // (r+1)*u1
+	wasSquare := fp.SqrtRatioM1(v, &oneMinusTwoD, v)
+	fp.Mul(vPrime, t, v)
+	fp.Cmov(vPrime, v, wasSquare) // vPrime = wasSquare ? v : t*v
+	fp.Neg(sgn, one)
+	fp.Cmov(sgn, one, wasSquare) // sgn = wasSquare ? 1 : -1
+	fp.Add(s, r, one)
+	fp.Mul(s, s, vPrime) // s = vPrime*(r+1)
+
+	w0, w1, w2, w3 := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+	*w0 = *s
+	cneg(w0, fp.IsNegative(w0))
+	fp.Add(w0, w0, w0) // w0 = 2*|s|
+	fp.Sqr(w2, s)
+	fp.Add(w1, w2, one) // w1 = s^2+1
+	fp.Sub(w2, w2, one) // w2 = s^2-1
+	fp.Sub(w3, r, one)
+	fp.Mul(w3, w3, s)
+	fp.Mul(w3, w3, vPrime)
+	fp.Mul(w3, w3, &oneMinusTwoD)
+	fp.Add(w3, w3, sgn) // w3 = vPrime*s*(r-1)*(1-2d) + sgn
+
+	var x, y, z, xy fp.Elt
+	fp.Mul(&x, w0, w3)
+	fp.Mul(&y, w2, w1)
+	fp.Mul(&z, w1, w3)
+	fp.Mul(&xy, w0, w2)
+	if !P.SetExtendedCoordinates(&x, &y, &z, &xy) {
+		panic("decaf448: invalid point")
+	}
+}
+
+// cneg sets x = -x if b is 1.
+func cneg(x *fp.Elt, b uint) {
+	var t fp.Elt
+	fp.Neg(&t, x)
+	fp.Cmov(x, &t, b)
+}
@@ -0,0 +1,12 @@
+// Package decaf448 provides the decaf448 prime-order group.
+//
+// Decaf448 is a group of prime order L, which is built from the Edwards448
+// curve. Unlike the group of points of Edwards448, it has no cofactor, so
+// it is suitable for protocols that require a prime-order group, such as
+// OPRFs, PAKEs, and anonymous credentials. All the operations of this
+// package are constant-time.
+//
+// References:
+//   - RFC9496 https://rfc-editor.org/rfc/rfc9496.txt
+//   - Decaf https://eprint.iacr.org/2015/673
+package decaf448
@@ -0,0 +1,3 @@
+// Package group provides prime-order groups and the groups of points of
+// some elliptic curves, for building cryptographic protocols.
+package group
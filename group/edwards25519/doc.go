@@ -0,0 +1,17 @@
+// Package edwards25519 provides the group of points of the twisted Edwards
+// curve edwards25519, and arithmetic modulo the order of its prime-order
+// subgroup.
+//
+// This package exposes the arithmetic used by Ed25519 (package
+// github.com/cloudflare/circl/sign/ed25519) to build other protocols on
+// the same curve. Point operations and scalar multiplications are
+// constant-time, unless the name of the function starts with VarTime.
+//
+// The group of points has order 8*L, where L is a prime number. Protocols
+// that require a prime-order group must either check that points belong to
+// the prime-order subgroup or clear the cofactor with MulByCofactor.
+//
+// References:
+//  - RFC8032 https://rfc-editor.org/rfc/rfc8032.txt
+//  - Twisted Edwards curves revisited. https://doi.org/10.1007/978-3-540-89255-7_20
+package edwards25519
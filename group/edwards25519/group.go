@@ -0,0 +1,179 @@
+package edwards25519
+
+import fp "github.com/cloudflare/circl/math/fp25519"
+
+// Size is the length in bytes of the encodings of points and scalars.
+const Size = 32
+
+// genX and genY are the affine coordinates of the generator point.
+var genX, genY = fp.Elt{
+	0x1a, 0xd5, 0x25, 0x8f, 0x60, 0x2d, 0x56, 0xc9,
+	0xb2, 0xa7, 0x25, 0x95, 0x60, 0xc7, 0x2c, 0x69,
+	0x5c, 0xdc, 0xd6, 0xfd, 0x31, 0xe2, 0xa4, 0xc0,
+	0xfe, 0x53, 0x6e, 0xcd, 0xd3, 0x36, 0x69, 0x21,
+}, fp.Elt{
+	0x58, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66,
+	0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66,
+	0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66,
+	0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66,
+}
+
+// Point is a point on the edwards25519 curve. The zero value is not a
+// valid point; use SetIdentity, SetGenerator, or Unmarshal to initialize
+// it.
+type Point struct{ p pointR1 }
+
+// SetIdentity sets P to the identity element (0,1).
+func (P *Point) SetIdentity() { P.p.SetIdentity() }
+
+// SetGenerator sets P to the generator point of the prime-order subgroup.
+func (P *Point) SetGenerator() {
+	P.p.x = genX
+	P.p.y = genY
+	fp.SetOne(&P.p.z)
+	P.p.ta = genX
+	P.p.tb = genY
+}
+
+// IsIdentity returns true if P is the identity element.
+func (P *Point) IsIdentity() bool { return P.p.isIdentity() }
+
+// IsEqual returns true if P and Q represent the same point.
+func (P *Point) IsEqual(Q *Point) bool { return P.p.isEqual(&Q.p) }
+
+// Neg calculates P = -Q.
+func (P *Point) Neg(Q *Point) { P.p = Q.p; P.p.neg() }
+
+// Add calculates P = Q + R.
+func (P *Point) Add(Q, R *Point) {
+	var S pointR2
+	S.fromR1(&R.p)
+	P.p = Q.p
+	P.p.add(&S)
+}
+
+// Sub calculates P = Q - R.
+func (P *Point) Sub(Q, R *Point) {
+	var S pointR2
+	S.fromR1(&R.p)
+	S.neg()
+	P.p = Q.p
+	P.p.add(&S)
+}
+
+// Double calculates P = 2Q.
+func (P *Point) Double(Q *Point) { P.p = Q.p; P.p.double() }
+
+// MulByCofactor calculates P = 8Q.
+func (P *Point) MulByCofactor(Q *Point) {
+	P.p = Q.p
+	P.p.double()
+	P.p.double()
+	P.p.double()
+}
+
+// ScalarMult calculates P = kQ.
+func (P *Point) ScalarMult(k *Scalar, Q *Point) {
+	R := Q.p
+	P.p.scalarMult(k.k[:], &R)
+}
+
+// ScalarBaseMult calculates P = kG, where G is the generator point.
+func (P *Point) ScalarBaseMult(k *Scalar) { P.p.fixedMult(k.k[:]) }
+
+// VarTimeDoubleScalarBaseMult calculates P = aQ + bG, where G is the
+// generator point. This function is not constant-time, so it must be used
+// only with public inputs.
+func (P *Point) VarTimeDoubleScalarBaseMult(a *Scalar, Q *Point, b *Scalar) {
+	R := Q.p
+	P.p.doubleMult(&R, b.k[:], a.k[:])
+}
+
+// VarTimeMultiScalarBaseMult calculates P = bG + sum(k[i]Q[i]), where G
+// is the generator point. This function is not constant-time, so it must
+// be used only with public inputs. It panics if k and Q have different
+// lengths.
+func (P *Point) VarTimeMultiScalarBaseMult(b *Scalar, k []Scalar, Q []Point) {
+	if len(k) != len(Q) {
+		panic("edwards25519: wrong number of scalars")
+	}
+	R := make([]pointR1, len(Q))
+	n := make([][]byte, len(k))
+	for i := range Q {
+		R[i] = Q[i].p
+		n[i] = k[i].k[:]
+	}
+	P.p.multiMult(b.k[:], R, n)
+}
+
+// ExtendedCoordinates returns the extended coordinates (X:Y:Z:T) of P,
+// such that x=X/Z, y=Y/Z, and xy=T/Z, where (x,y) are the affine
+// coordinates of P.
+func (P *Point) ExtendedCoordinates() (X, Y, Z, T fp.Elt) {
+	X, Y, Z = P.p.x, P.p.y, P.p.z
+	fp.Mul(&T, &P.p.ta, &P.p.tb)
+	return
+}
+
+// SetExtendedCoordinates sets P to the point with extended coordinates
+// (X:Y:Z:T), and returns false if they do not represent a point on the
+// curve. In that case, P is not modified.
+func (P *Point) SetExtendedCoordinates(X, Y, Z, T *fp.Elt) bool {
+	l, r, t := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+	if z := *Z; fp.IsZero(&z) {
+		return false
+	}
+	// Checks that XY = ZT.
+	fp.Mul(l, X, Y)
+	fp.Mul(r, Z, T)
+	fp.Sub(l, l, r)
+	if !fp.IsZero(l) {
+		return false
+	}
+	// Checks that -X^2 + Y^2 = Z^2 + dT^2.
+	fp.Sqr(l, Y)
+	fp.Sqr(t, X)
+	fp.Sub(l, l, t)
+	fp.Sqr(r, T)
+	fp.Mul(r, r, &paramD)
+	fp.Sqr(t, Z)
+	fp.Add(r, r, t)
+	fp.Sub(l, l, r)
+	if !fp.IsZero(l) {
+		return false
+	}
+	P.p.x, P.p.y, P.p.z, P.p.ta = *X, *Y, *Z, *T
+	fp.SetOne(&P.p.tb)
+	return true
+}
+
+// Marshal stores the canonical encoding of P in out, as specified in
+// RFC-8032.
+func (P *Point) Marshal(out *[Size]byte) {
+	Q := P.p
+	Q.ToBytes(out[:])
+}
+
+// Unmarshal sets P to the point encoded in the input, and returns false
+// if it is not the canonical encoding of a point on the curve. In that
+// case, P is not modified.
+func (P *Point) Unmarshal(in *[Size]byte) bool {
+	var Q pointR1
+	if !Q.FromBytes(in[:]) {
+		return false
+	}
+	P.p = Q
+	return true
+}
+
+// UnmarshalNonCanonical is like Unmarshal, but it also accepts the
+// non-canonical encodings allowed by ZIP-215: the y-coordinate can be
+// greater than or equal to p, and the sign bit can be set when x=0.
+func (P *Point) UnmarshalNonCanonical(in *[Size]byte) bool {
+	var Q pointR1
+	if !Q.fromBytes(in[:], false) {
+		return false
+	}
+	P.p = Q
+	return true
+}
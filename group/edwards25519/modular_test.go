@@ -1,4 +1,4 @@
-package ed25519
+package edwards25519
 
 import (
 	"crypto/rand"
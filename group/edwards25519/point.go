@@ -1,4 +1,4 @@
-package ed25519
+package edwards25519
 
 import fp "github.com/cloudflare/circl/math/fp25519"
 
@@ -42,7 +42,15 @@ func (P *pointR1) ToBytes(k []byte) {
 	k[Size-1] = k[Size-1] | (b << 7)
 }
 
-func (P *pointR1) FromBytes(k []byte) bool {
+// FromBytes decodes a point from its canonical encoding, and returns false
+// if k is not the canonical encoding of a point on the curve.
+func (P *pointR1) FromBytes(k []byte) bool { return P.fromBytes(k, true) }
+
+// fromBytes decodes a point as described in RFC-8032. If canonical is true,
+// it rejects y-coordinates greater than or equal to p, and the sign bit set
+// when x=0. Otherwise, such non-canonical encodings are accepted, as
+// specified by ZIP-215; y is then reduced modulo p.
+func (P *pointR1) fromBytes(k []byte, canonical bool) bool {
 	if len(k) != Size {
 		panic("wrong size")
 	}
@@ -51,7 +59,10 @@ func (P *pointR1) FromBytes(k []byte) bool {
 	P.y[fp.Size-1] &= 0x7F
 	p := fp.P()
 	if !isLessThan(P.y[:], p[:]) {
-		return false
+		if canonical {
+			return false
+		}
+		fp.Modp(&P.y)
 	}
 
 	one, u, v := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
@@ -65,7 +76,7 @@ func (P *pointR1) FromBytes(k []byte) bool {
 		return false
 	}
 	fp.Modp(&P.x) // x = x mod p
-	if fp.IsZero(&P.x) && signX == 1 {
+	if canonical && fp.IsZero(&P.x) && signX == 1 {
 		return false
 	}
 	if signX != (P.x[0] & 1) {
@@ -139,23 +150,26 @@ func (P *pointR1) oddMultiples(T []pointR2) {
 	}
 }
 
+func (P *pointR1) isIdentity() bool {
+	b0 := fp.IsEqual(&P.x, &fp.Elt{})
+	b1 := fp.IsEqual(&P.y, &P.z)
+	return (b0 & b1) == 1
+}
+
 func (P *pointR1) isEqual(Q *pointR1) bool {
 	l, r := &fp.Elt{}, &fp.Elt{}
 	fp.Mul(l, &P.x, &Q.z)
 	fp.Mul(r, &Q.x, &P.z)
-	fp.Sub(l, l, r)
-	b := fp.IsZero(l)
+	b0 := fp.IsEqual(l, r)
 	fp.Mul(l, &P.y, &Q.z)
 	fp.Mul(r, &Q.y, &P.z)
-	fp.Sub(l, l, r)
-	b = b && fp.IsZero(l)
+	b1 := fp.IsEqual(l, r)
 	fp.Mul(l, &P.ta, &P.tb)
 	fp.Mul(l, l, &Q.z)
 	fp.Mul(r, &Q.ta, &Q.tb)
 	fp.Mul(r, r, &P.z)
-	fp.Sub(l, l, r)
-	b = b && fp.IsZero(l)
-	return b
+	b2 := fp.IsEqual(l, r)
+	return (b0 & b1 & b2) == 1
 }
 
 func (P *pointR3) neg() {
@@ -179,6 +193,19 @@ func (P *pointR3) cneg(b int) {
 	fp.Cmov(&P.dt2, t, uint(b))
 }
 
+func (P *pointR2) SetIdentity() {
+	fp.SetOne(&P.addYX)
+	fp.SetOne(&P.subYX)
+	P.dt2 = fp.Elt{}
+	fp.SetOne(&P.z2)
+	fp.Add(&P.z2, &P.z2, &P.z2)
+}
+
+func (P *pointR2) cmov(Q *pointR2, b int) {
+	P.pointR3.cmov(&Q.pointR3, b)
+	fp.Cmov(&P.z2, &Q.z2, uint(b))
+}
+
 func (P *pointR3) cmov(Q *pointR3, b int) {
 	fp.Cmov(&P.addYX, &Q.addYX, uint(b))
 	fp.Cmov(&P.subYX, &Q.subYX, uint(b))
@@ -0,0 +1,189 @@
+package edwards25519
+
+import (
+	"crypto/rand"
+	"flag"
+	"testing"
+
+	"github.com/cloudflare/circl/internal/test"
+	fp "github.com/cloudflare/circl/math/fp25519"
+)
+
+func randomPoint(P *pointR1) {
+	k := make([]byte, Size)
+	_, _ = rand.Read(k[:])
+	P.fixedMult(k)
+}
+
+func TestPoint(t *testing.T) {
+	const testTimes = 1 << 10
+
+	t.Run("add", func(t *testing.T) {
+		var P pointR1
+		var Q pointR1
+		var R pointR2
+		for i := 0; i < testTimes; i++ {
+			randomPoint(&P)
+			_16P := P
+			R.fromR1(&P)
+			// 16P = 2^4P
+			for j := 0; j < 4; j++ {
+				_16P.double()
+			}
+			// 16P = P+P...+P
+			Q.SetIdentity()
+			for j := 0; j < 16; j++ {
+				Q.add(&R)
+			}
+
+			got := _16P.isEqual(&Q)
+			want := true
+			if got != want {
+				test.ReportError(t, got, want, P)
+			}
+		}
+	})
+
+	t.Run("fixed", func(t *testing.T) {
+		var P, Q, R pointR1
+		k := make([]byte, Size)
+		l := make([]byte, Size)
+		for i := 0; i < testTimes; i++ {
+			randomPoint(&P)
+			_, _ = rand.Read(k[:])
+
+			Q.fixedMult(k[:])
+			R.doubleMult(&P, k[:], l[:])
+
+			got := Q.isEqual(&R)
+			want := true
+			if got != want {
+				test.ReportError(t, got, want, P, k)
+			}
+		}
+	})
+
+	t.Run("isEqual", func(t *testing.T) {
+		var P, Q, O pointR1
+		var R pointR2
+		var s fp.Elt
+		O.SetIdentity()
+		for i := 0; i < testTimes; i++ {
+			randomPoint(&P)
+			_, _ = rand.Read(s[:])
+			// Q = P in a different projective representation.
+			Q = P
+			fp.Mul(&Q.x, &Q.x, &s)
+			fp.Mul(&Q.y, &Q.y, &s)
+			fp.Mul(&Q.z, &Q.z, &s)
+			fp.Mul(&Q.ta, &Q.ta, &s)
+			if got, want := P.isEqual(&Q), true; got != want {
+				test.ReportError(t, got, want, P, s)
+			}
+			if got, want := P.isIdentity(), false; got != want {
+				test.ReportError(t, got, want, P)
+			}
+			// Q = 2P.
+			R.fromR1(&P)
+			Q.add(&R)
+			if got, want := P.isEqual(&Q), false; got != want {
+				test.ReportError(t, got, want, P)
+			}
+			// Q = 2P - 2P, with z scaled by s.
+			P = Q
+			Q.neg()
+			R.fromR1(&Q)
+			P.add(&R)
+			fp.Mul(&P.x, &P.x, &s)
+			fp.Mul(&P.y, &P.y, &s)
+			fp.Mul(&P.z, &P.z, &s)
+			fp.Mul(&P.ta, &P.ta, &s)
+			if got, want := P.isIdentity(), true; got != want {
+				test.ReportError(t, got, want, P)
+			}
+			if got, want := P.isEqual(&O), true; got != want {
+				test.ReportError(t, got, want, P)
+			}
+		}
+	})
+
+	t.Run("multi", func(t *testing.T) {
+		const numPoints = 4
+		var P, Q, R pointR1
+		var S pointR2
+		m := make([]byte, Size)
+		Qs := make([]pointR1, numPoints)
+		ns := make([][]byte, numPoints)
+		for i := 0; i < testTimes/numPoints; i++ {
+			_, _ = rand.Read(m[:])
+			reduceModOrder(m[:], false)
+			Q.fixedMult(m)
+			for j := range Qs {
+				randomPoint(&Qs[j])
+				ns[j] = make([]byte, Size)
+				_, _ = rand.Read(ns[j])
+				R = Qs[j]
+				P.doubleMult(&R, make([]byte, Size), ns[j])
+				S.fromR1(&P)
+				Q.add(&S)
+			}
+			P.multiMult(m, Qs, ns)
+
+			got := P.isEqual(&Q)
+			want := true
+			if got != want {
+				test.ReportError(t, got, want, m, ns)
+			}
+		}
+	})
+}
+
+var runLongBench = flag.Bool("long", false, "runs longer benchmark")
+
+func BenchmarkPoint(b *testing.B) {
+	if !*runLongBench {
+		b.Log("Skipped one long bench, add -long flag to run longer bench")
+		b.SkipNow()
+	}
+
+	k := make([]byte, Size)
+	l := make([]byte, Size)
+	_, _ = rand.Read(k)
+	_, _ = rand.Read(l)
+
+	var P pointR1
+	var Q pointR2
+	var R pointR3
+	randomPoint(&P)
+	Q.fromR1(&P)
+	b.Run("toAffine", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.toAffine()
+		}
+	})
+	b.Run("double", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.double()
+		}
+	})
+	b.Run("mixadd", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.mixAdd(&R)
+		}
+	})
+	b.Run("add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.add(&Q)
+		}
+	})
+	b.Run("fixedMult", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.fixedMult(k)
+		}
+	})
+	b.Run("doubleMult", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.doubleMult(&P, k, l)
+		}
+	})
+}
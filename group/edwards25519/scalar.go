@@ -0,0 +1,121 @@
+package edwards25519
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"io"
+	"math/bits"
+)
+
+// Scalar is an integer modulo the order of the prime-order subgroup,
+// L = 2^252 + 27742317777372353535851937790883648493. Scalars are always
+// reduced modulo L. The zero value is the scalar 0.
+type Scalar struct{ k [Size]byte }
+
+// orderMinusOne is L-1, which is congruent to -1 modulo L.
+var orderMinusOne = [Size]byte{
+	0xec, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58,
+	0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+}
+
+// SetUint64 sets z = x.
+func (z *Scalar) SetUint64(x uint64) {
+	z.k = [Size]byte{}
+	binary.LittleEndian.PutUint64(z.k[:8], x)
+}
+
+// IsZero returns true if z = 0.
+func (z *Scalar) IsZero() bool { return z.IsEqual(&Scalar{}) }
+
+// IsEqual returns true if z = x.
+func (z *Scalar) IsEqual(x *Scalar) bool {
+	return subtle.ConstantTimeCompare(z.k[:], x.k[:]) == 1
+}
+
+// Add calculates z = x + y mod L.
+func (z *Scalar) Add(x, y *Scalar) {
+	var one Scalar
+	one.SetUint64(1)
+	calculateS(z.k[:], x.k[:], one.k[:], y.k[:])
+}
+
+// Sub calculates z = x - y mod L.
+func (z *Scalar) Sub(x, y *Scalar) { calculateS(z.k[:], x.k[:], orderMinusOne[:], y.k[:]) }
+
+// Neg calculates z = -x mod L.
+func (z *Scalar) Neg(x *Scalar) {
+	var zero Scalar
+	calculateS(z.k[:], zero.k[:], orderMinusOne[:], x.k[:])
+}
+
+// Mul calculates z = x * y mod L.
+func (z *Scalar) Mul(x, y *Scalar) {
+	var zero Scalar
+	calculateS(z.k[:], zero.k[:], x.k[:], y.k[:])
+}
+
+// MulAdd calculates z = x * y + w mod L.
+func (z *Scalar) MulAdd(x, y, w *Scalar) { calculateS(z.k[:], w.k[:], x.k[:], y.k[:]) }
+
+// Inv calculates z = 1/x mod L using Fermat's little theorem, so z = 0 if
+// x = 0.
+func (z *Scalar) Inv(x *Scalar) {
+	// Since the exponent L-2 is public, the sequence of operations does
+	// not depend on x.
+	var exp [Size]byte
+	copy(exp[:], orderMinusOne[:])
+	exp[0]--
+	var t Scalar
+	t.SetUint64(1)
+	xx := *x
+	for i := 8*Size - 1; i >= 0; i-- {
+		t.Mul(&t, &t)
+		if (exp[i/8]>>uint(i%8))&1 == 1 {
+			t.Mul(&t, &xx)
+		}
+	}
+	*z = t
+}
+
+// SetBytesWide sets z = in mod L, where in is a 64-byte integer encoded in
+// little-endian order, such as the output of a hash function.
+func (z *Scalar) SetBytesWide(in *[2 * Size]byte) {
+	var k [2 * Size]byte
+	copy(k[:], in[:])
+	reduceModOrder(k[:], true)
+	copy(z.k[:], k[:Size])
+}
+
+// Random sets z to a uniformly random scalar, using entropy from rnd.
+func (z *Scalar) Random(rnd io.Reader) error {
+	var k [2 * Size]byte
+	if _, err := io.ReadFull(rnd, k[:]); err != nil {
+		return err
+	}
+	z.SetBytesWide(&k)
+	return nil
+}
+
+// Marshal stores z in out as a 32-byte integer in little-endian order.
+func (z *Scalar) Marshal(out *[Size]byte) { *out = z.k }
+
+// Unmarshal sets z to the integer encoded in little-endian order in the
+// input, and returns false if it is not less than L. In that case, z is
+// not modified.
+func (z *Scalar) Unmarshal(in *[Size]byte) bool {
+	// Computes in - L in constant time; in < L if and only if there is a
+	// borrow.
+	var borrow uint64
+	for i := 0; i < numWords64; i++ {
+		x := binary.LittleEndian.Uint64(in[8*i : 8*i+8])
+		l := binary.LittleEndian.Uint64(order[8*i : 8*i+8])
+		_, borrow = bits.Sub64(x, l, borrow)
+	}
+	if borrow == 0 {
+		return false
+	}
+	z.k = *in
+	return true
+}
@@ -0,0 +1,19 @@
+// Package edwards448 provides the group of points of the Edwards448 curve
+// (also known as Ed448-Goldilocks), and arithmetic modulo the order of its
+// prime-order subgroup.
+//
+// This package exposes the arithmetic used by Ed448 (package
+// github.com/cloudflare/circl/sign/ed448) to build other protocols on the
+// same curve. Point operations and scalar multiplications are
+// constant-time, unless the name of the function starts with VarTime.
+//
+// The group of points has order 4*L, where L is a prime number. Protocols
+// that require a prime-order group must either check that points belong to
+// the prime-order subgroup, clear the cofactor with MulByCofactor, or use
+// the decaf448 group (package github.com/cloudflare/circl/group/decaf448).
+//
+// References:
+//   - RFC8032 https://rfc-editor.org/rfc/rfc8032.txt
+//   - Ed448-Goldilocks https://eprint.iacr.org/2015/625
+//   - Twisted Edwards curves revisited https://doi.org/10.1007/978-3-540-89255-7_20
+package edwards448
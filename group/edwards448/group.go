@@ -0,0 +1,157 @@
+package edwards448
+
+import fp "github.com/cloudflare/circl/math/fp448"
+
+// Size is the length in bytes of the encoding of points.
+const Size = 57
+
+// ScalarSize is the length in bytes of the encoding of scalars.
+const ScalarSize = 56
+
+// genX and genY are the affine coordinates of the generator point.
+var genX, genY = fp.Elt{
+	0x5e, 0xc0, 0x0c, 0xc7, 0x2b, 0xa8, 0x26, 0x26,
+	0x8e, 0x93, 0x00, 0x8b, 0xe1, 0x80, 0x3b, 0x43,
+	0x11, 0x65, 0xb6, 0x2a, 0xf7, 0x1a, 0xae, 0x12,
+	0x64, 0xa4, 0xd3, 0xa3, 0x24, 0xe3, 0x6d, 0xea,
+	0x67, 0x17, 0x0f, 0x47, 0x70, 0x65, 0x14, 0x9e,
+	0xda, 0x36, 0xbf, 0x22, 0xa6, 0x15, 0x1d, 0x22,
+	0xed, 0x0d, 0xed, 0x6b, 0xc6, 0x70, 0x19, 0x4f,
+}, fp.Elt{
+	0x14, 0xfa, 0x30, 0xf2, 0x5b, 0x79, 0x08, 0x98,
+	0xad, 0xc8, 0xd7, 0x4e, 0x2c, 0x13, 0xbd, 0xfd,
+	0xc4, 0x39, 0x7c, 0xe6, 0x1c, 0xff, 0xd3, 0x3a,
+	0xd7, 0xc2, 0xa0, 0x05, 0x1e, 0x9c, 0x78, 0x87,
+	0x40, 0x98, 0xa3, 0x6c, 0x73, 0x73, 0xea, 0x4b,
+	0x62, 0xc7, 0xc9, 0x56, 0x37, 0x20, 0x76, 0x88,
+	0x24, 0xbc, 0xb6, 0x6e, 0x71, 0x46, 0x3f, 0x69,
+}
+
+// Point is a point on the Edwards448 curve. The zero value is not a valid
+// point; use SetIdentity, SetGenerator, or Unmarshal to initialize it.
+type Point struct{ p pointR1 }
+
+// SetIdentity sets P to the identity element (0,1).
+func (P *Point) SetIdentity() { P.p.SetIdentity() }
+
+// SetGenerator sets P to the generator point of the prime-order subgroup.
+func (P *Point) SetGenerator() {
+	P.p.x = genX
+	P.p.y = genY
+	fp.SetOne(&P.p.z)
+	P.p.ta = genX
+	P.p.tb = genY
+}
+
+// IsIdentity returns true if P is the identity element.
+func (P *Point) IsIdentity() bool { return P.p.isIdentity() }
+
+// IsEqual returns true if P and Q represent the same point.
+func (P *Point) IsEqual(Q *Point) bool { return P.p.isEqual(&Q.p) }
+
+// Neg calculates P = -Q.
+func (P *Point) Neg(Q *Point) { P.p = Q.p; P.p.neg() }
+
+// Add calculates P = Q + R.
+func (P *Point) Add(Q, R *Point) {
+	var S pointR2
+	S.fromR1(&R.p)
+	P.p = Q.p
+	P.p.add(&S)
+}
+
+// Sub calculates P = Q - R.
+func (P *Point) Sub(Q, R *Point) {
+	var S pointR2
+	S.fromR1(&R.p)
+	S.neg()
+	P.p = Q.p
+	P.p.add(&S)
+}
+
+// Double calculates P = 2Q.
+func (P *Point) Double(Q *Point) { P.p = Q.p; P.p.double() }
+
+// MulByCofactor calculates P = 4Q.
+func (P *Point) MulByCofactor(Q *Point) {
+	P.p = Q.p
+	P.p.double()
+	P.p.double()
+}
+
+// ScalarMult calculates P = kQ.
+func (P *Point) ScalarMult(k *Scalar, Q *Point) {
+	R := Q.p
+	P.p.scalarMult(k.k[:], &R)
+}
+
+// ScalarBaseMult calculates P = kG, where G is the generator point.
+func (P *Point) ScalarBaseMult(k *Scalar) { P.p.fixedMult(k.k[:]) }
+
+// VarTimeDoubleScalarBaseMult calculates P = aQ + bG, where G is the
+// generator point. This function is not constant-time, so it must be used
+// only with public inputs.
+func (P *Point) VarTimeDoubleScalarBaseMult(a *Scalar, Q *Point, b *Scalar) {
+	R := Q.p
+	P.p.doubleMult(&R, b.k[:], a.k[:])
+}
+
+// ExtendedCoordinates returns the extended coordinates (X:Y:Z:T) of P,
+// such that x=X/Z, y=Y/Z, and xy=T/Z, where (x,y) are the affine
+// coordinates of P.
+func (P *Point) ExtendedCoordinates() (X, Y, Z, T fp.Elt) {
+	X, Y, Z = P.p.x, P.p.y, P.p.z
+	fp.Mul(&T, &P.p.ta, &P.p.tb)
+	return
+}
+
+// SetExtendedCoordinates sets P to the point with extended coordinates
+// (X:Y:Z:T), and returns false if they do not represent a point on the
+// curve. In that case, P is not modified.
+func (P *Point) SetExtendedCoordinates(X, Y, Z, T *fp.Elt) bool {
+	l, r, t := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+	if z := *Z; fp.IsZero(&z) {
+		return false
+	}
+	// Checks that XY = ZT.
+	fp.Mul(l, X, Y)
+	fp.Mul(r, Z, T)
+	fp.Sub(l, l, r)
+	if !fp.IsZero(l) {
+		return false
+	}
+	// Checks that X^2 + Y^2 = Z^2 + dT^2.
+	fp.Sqr(l, Y)
+	fp.Sqr(t, X)
+	fp.Add(l, l, t)
+	fp.Sqr(r, T)
+	fp.Mul(r, r, &paramD)
+	fp.Sqr(t, Z)
+	fp.Add(r, r, t)
+	fp.Sub(l, l, r)
+	if !fp.IsZero(l) {
+		return false
+	}
+	P.p.x, P.p.y, P.p.z, P.p.ta = *X, *Y, *Z, *T
+	fp.SetOne(&P.p.tb)
+	return true
+}
+
+// Marshal stores the canonical encoding of P in out, as specified in
+// RFC-8032.
+func (P *Point) Marshal(out *[Size]byte) {
+	Q := P.p
+	Q.ToBytes(out[:])
+}
+
+// Unmarshal sets P to the point encoded in the input, and returns false
+// if it is not the canonical encoding of a point on the curve. In that
+// case, P is not modified.
+func (P *Point) Unmarshal(in *[Size]byte) bool {
+	var Q pointR1
+	if !Q.FromBytes(in[:]) {
+		return false
+	}
+	P.p = Q
+	return true
+}
@@ -0,0 +1,273 @@
+package edwards448_test
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/cloudflare/circl/group/edwards448"
+	"github.com/cloudflare/circl/internal/conv"
+	"github.com/cloudflare/circl/internal/test"
+)
+
+const testTimes = 1 << 8
+
+var orderBig, _ = new(big.Int).SetString("3fffffffffffffffffffffffffffffffffffffffffffffffffffffff7cca23e9c44edb49aed63690216cc2728dc58f552378c292ab5844f3", 16)
+
+func scalarToBig(k *edwards448.Scalar) *big.Int {
+	var b [edwards448.ScalarSize]byte
+	k.Marshal(&b)
+	return conv.BytesLe2BigInt(b[:])
+}
+
+func randomScalar(t testing.TB) (k edwards448.Scalar) {
+	err := k.Random(rand.Reader)
+	test.CheckNoErr(t, err, "random scalar failed")
+	return
+}
+
+func randomPoint(t testing.TB) (P edwards448.Point) {
+	k := randomScalar(t)
+	P.ScalarBaseMult(&k)
+	return
+}
+
+// lowOrderPoint returns the point (1, 0) of order 4.
+func lowOrderPoint() (P edwards448.Point) {
+	var enc [edwards448.Size]byte
+	enc[edwards448.Size-1] = 0x80
+	if !P.Unmarshal(&enc) {
+		panic("invalid point")
+	}
+	return
+}
+
+func TestScalar(t *testing.T) {
+	t.Run("arith", func(t *testing.T) {
+		var z edwards448.Scalar
+		for i := 0; i < testTimes; i++ {
+			x, y, w := randomScalar(t), randomScalar(t), randomScalar(t)
+			bx, by, bw := scalarToBig(&x), scalarToBig(&y), scalarToBig(&w)
+
+			for _, op := range []struct {
+				name string
+				calc func()
+				want *big.Int
+			}{
+				{"add", func() { z.Add(&x, &y) }, new(big.Int).Add(bx, by)},
+				{"sub", func() { z.Sub(&x, &y) }, new(big.Int).Sub(bx, by)},
+				{"neg", func() { z.Neg(&x) }, new(big.Int).Neg(bx)},
+				{"mul", func() { z.Mul(&x, &y) }, new(big.Int).Mul(bx, by)},
+				{"muladd", func() { z.MulAdd(&x, &y, &w) }, new(big.Int).Add(new(big.Int).Mul(bx, by), bw)},
+				{"inv", func() { z.Inv(&x) }, new(big.Int).ModInverse(bx, orderBig)},
+			} {
+				op.calc()
+				got := scalarToBig(&z)
+				want := op.want.Mod(op.want, orderBig)
+				if got.Cmp(want) != 0 {
+					test.ReportError(t, got, want, op.name, bx, by, bw)
+				}
+			}
+		}
+	})
+
+	t.Run("aliasing", func(t *testing.T) {
+		x, y := randomScalar(t), randomScalar(t)
+		var want edwards448.Scalar
+		want.MulAdd(&x, &x, &y)
+		x.MulAdd(&x, &x, &y)
+		if !x.IsEqual(&want) {
+			test.ReportError(t, x, want)
+		}
+	})
+
+	t.Run("inverse", func(t *testing.T) {
+		var z, one, zero edwards448.Scalar
+		one.SetUint64(1)
+		for i := 0; i < testTimes; i++ {
+			x := randomScalar(t)
+			z.Inv(&x)
+			z.Mul(&z, &x)
+			if !z.IsEqual(&one) {
+				test.ReportError(t, z, one, x)
+			}
+		}
+		z.Inv(&zero)
+		if !z.IsZero() {
+			test.ReportError(t, z.IsZero(), true)
+		}
+	})
+
+	t.Run("wide", func(t *testing.T) {
+		var z edwards448.Scalar
+		var in [2 * edwards448.Size]byte
+		for i := 0; i < testTimes; i++ {
+			_, _ = rand.Read(in[:])
+			z.SetBytesWide(&in)
+			got := scalarToBig(&z)
+			want := conv.BytesLe2BigInt(in[:])
+			want.Mod(want, orderBig)
+			if got.Cmp(want) != 0 {
+				test.ReportError(t, got, want, in)
+			}
+		}
+	})
+
+	t.Run("marshal", func(t *testing.T) {
+		var z edwards448.Scalar
+		var enc [edwards448.ScalarSize]byte
+		for _, c := range []struct {
+			n    *big.Int
+			want bool
+		}{
+			{big.NewInt(0), true},
+			{new(big.Int).Sub(orderBig, big.NewInt(1)), true},
+			{orderBig, false},
+			{new(big.Int).Add(orderBig, big.NewInt(1)), false},
+			{new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 448), big.NewInt(1)), false},
+		} {
+			conv.BigInt2BytesLe(enc[:], c.n)
+			got := z.Unmarshal(&enc)
+			if got != c.want {
+				test.ReportError(t, got, c.want, c.n)
+			}
+			if got {
+				var out [edwards448.ScalarSize]byte
+				z.Marshal(&out)
+				if out != enc {
+					test.ReportError(t, out, enc, c.n)
+				}
+			}
+		}
+	})
+}
+
+func TestPoint(t *testing.T) {
+	t.Run("arith", func(t *testing.T) {
+		var R, S edwards448.Point
+		for i := 0; i < testTimes; i++ {
+			P, Q := randomPoint(t), randomPoint(t)
+			R.Add(&P, &Q)
+			S.Sub(&R, &Q)
+			if !S.IsEqual(&P) {
+				test.ReportError(t, S, P)
+			}
+			R.Double(&P)
+			S.Add(&P, &P)
+			if !R.IsEqual(&S) {
+				test.ReportError(t, R, S)
+			}
+			R.Neg(&P)
+			S.Add(&P, &R)
+			if !S.IsIdentity() {
+				test.ReportError(t, S.IsIdentity(), true)
+			}
+		}
+	})
+
+	t.Run("generator", func(t *testing.T) {
+		var G, P edwards448.Point
+		var one edwards448.Scalar
+		one.SetUint64(1)
+		G.SetGenerator()
+		P.ScalarBaseMult(&one)
+		if !P.IsEqual(&G) {
+			test.ReportError(t, P, G)
+		}
+	})
+
+	t.Run("scalarMult", func(t *testing.T) {
+		var G, P, Q, R edwards448.Point
+		var zero edwards448.Scalar
+		G.SetGenerator()
+		T := lowOrderPoint()
+		for i := 0; i < testTimes; i++ {
+			k := randomScalar(t)
+			P.ScalarMult(&k, &G)
+			Q.ScalarBaseMult(&k)
+			if !P.IsEqual(&Q) {
+				test.ReportError(t, P, Q, k)
+			}
+
+			// Points out of the prime-order subgroup.
+			R = randomPoint(t)
+			R.Add(&R, &T)
+			P.ScalarMult(&k, &R)
+			Q.VarTimeDoubleScalarBaseMult(&k, &R, &zero)
+			if !P.IsEqual(&Q) {
+				test.ReportError(t, P, Q, k)
+			}
+		}
+	})
+
+	t.Run("cofactor", func(t *testing.T) {
+		var P edwards448.Point
+		T := lowOrderPoint()
+		P.MulByCofactor(&T)
+		if !P.IsIdentity() {
+			test.ReportError(t, P.IsIdentity(), true)
+		}
+	})
+
+	t.Run("marshal", func(t *testing.T) {
+		var Q edwards448.Point
+		var enc [edwards448.Size]byte
+		for i := 0; i < testTimes; i++ {
+			P := randomPoint(t)
+			P.Marshal(&enc)
+			if !Q.Unmarshal(&enc) {
+				test.ReportError(t, false, true, enc)
+			}
+			if !Q.IsEqual(&P) {
+				test.ReportError(t, Q, P, enc)
+			}
+		}
+
+		// The identity with the sign bit set is a non-canonical encoding.
+		enc = [edwards448.Size]byte{0x01}
+		enc[edwards448.Size-1] = 0x80
+		got := Q.Unmarshal(&enc)
+		want := false
+		if got != want {
+			test.ReportError(t, got, want, enc)
+		}
+	})
+}
+
+func BenchmarkPoint(b *testing.B) {
+	k := randomScalar(b)
+	l := randomScalar(b)
+	P := randomPoint(b)
+
+	b.Run("ScalarMult", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.ScalarMult(&k, &P)
+		}
+	})
+	b.Run("ScalarBaseMult", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.ScalarBaseMult(&k)
+		}
+	})
+	b.Run("VarTimeDoubleScalarBaseMult", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			P.VarTimeDoubleScalarBaseMult(&k, &P, &l)
+		}
+	})
+}
+
+func BenchmarkScalar(b *testing.B) {
+	x := randomScalar(b)
+	y := randomScalar(b)
+
+	b.Run("Mul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x.Mul(&x, &y)
+		}
+	})
+	b.Run("Inv", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x.Inv(&x)
+		}
+	})
+}
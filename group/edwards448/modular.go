@@ -0,0 +1,136 @@
+package edwards448
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// order is the order of the prime subgroup of the Edwards448 curve.
+var order = [Size]byte{
+	0xf3, 0x44, 0x58, 0xab, 0x92, 0xc2, 0x78, 0x23,
+	0x55, 0x8f, 0xc5, 0x8d, 0x72, 0xc2, 0x6c, 0x21,
+	0x90, 0x36, 0xd6, 0xae, 0x49, 0xdb, 0x4e, 0xc4,
+	0xe9, 0x23, 0xca, 0x7c, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x3f,
+	0x00,
+}
+
+// isLessThan returns true if 0 <= x < y, and assumes that slices have the same length.
+func isLessThan(x, y []byte) bool {
+	i := len(x) - 1
+	for i > 0 && x[i] == y[i] {
+		i--
+	}
+	return x[i] < y[i]
+}
+
+// wideWords is the number of 64-bit words needed to store a product of
+// two scalars.
+const wideWords = 2*numWords64 + 1
+
+// orderC is the number c such that order = 2^446 - c.
+var orderC = [4]uint64{
+	0xdc873d6d54a7bb0d, 0xde933d8d723a70aa, 0x3bb124b65129c96f, 0x000000008335dc16,
+}
+
+// reduceModOrder calculates k = k mod order of the curve. The slice k
+// must be at most 2*Size bytes long.
+func reduceModOrder(k []byte) {
+	var X [wideWords]uint64
+	var b [wideWords * 8]byte
+	copy(b[:], k)
+	for i := range X {
+		X[i] = binary.LittleEndian.Uint64(b[i*8 : (i+1)*8])
+	}
+	red(&X)
+	for i := 0; i < numWords64; i++ {
+		binary.LittleEndian.PutUint64(b[i*8:(i+1)*8], X[i])
+	}
+	for i := range k {
+		k[i] = 0
+	}
+	copy(k, b[:numWords64*8])
+}
+
+// red calculates x = x mod order of the curve. On return, only the first
+// numWords64 words of x can be non-zero.
+func red(x *[wideWords]uint64) {
+	// Since 2^446 = c mod order, each folding step replaces x by
+	// (x mod 2^446) + (x >> 446)*c. Three steps reduce a 913-bit number
+	// to a number less than 2*order.
+	fold(x[:], 8)
+	fold(x[:], 4)
+	fold(x[:], 1)
+
+	// Subtracts order if x >= order.
+	var y [numWords64]uint64
+	var borrow uint64
+	for i := range y {
+		o := binary.LittleEndian.Uint64(order[i*8 : (i+1)*8])
+		y[i], borrow = bits.Sub64(x[i], o, borrow)
+	}
+	mask := borrow - 1 // if x < order then mask=0...0 else mask=1...1
+	for i := range y {
+		x[i] = (x[i] &^ mask) | (y[i] & mask)
+	}
+}
+
+// fold calculates x = (x mod 2^446) + (x >> 446)*c, assuming that x >> 446
+// fits in n words.
+func fold(x []uint64, n int) {
+	var hi [wideWords - numWords64]uint64
+	for i := 0; i < n; i++ {
+		hi[i] = (x[numWords64-1+i] >> 62) | (x[numWords64+i] << 2)
+	}
+	x[numWords64-1] &= (uint64(1) << 62) - 1
+	for i := numWords64; i < len(x); i++ {
+		x[i] = 0
+	}
+	addMul(x, hi[:n], orderC[:])
+}
+
+// addMul calculates z = z + x*y, assuming that the result fits in z.
+func addMul(z, x, y []uint64) {
+	for i := range x {
+		var carry uint64
+		for j := range y {
+			h, l := bits.Mul64(x[i], y[j])
+			var c uint64
+			l, c = bits.Add64(l, carry, 0)
+			h += c
+			z[i+j], c = bits.Add64(z[i+j], l, 0)
+			carry = h + c
+		}
+		for j := i + len(y); j < len(z); j++ {
+			z[j], carry = bits.Add64(z[j], carry, 0)
+		}
+	}
+}
+
+// calculateS performs s = r+k*a mod order of the curve.
+func calculateS(s, r, k, a []byte) {
+	var K, A [numWords64 + 1]uint64
+	var S [wideWords]uint64
+	var b [(numWords64 + 1) * 8]byte
+	copy(b[:], k[:Size])
+	for i := range K {
+		K[i] = binary.LittleEndian.Uint64(b[i*8 : (i+1)*8])
+	}
+	copy(b[:], a[:Size])
+	for i := range A {
+		A[i] = binary.LittleEndian.Uint64(b[i*8 : (i+1)*8])
+	}
+	copy(b[:], r[:Size])
+	for i := range K {
+		S[i] = binary.LittleEndian.Uint64(b[i*8 : (i+1)*8])
+	}
+	addMul(S[:], K[:], A[:])
+	red(&S)
+	for i := 0; i < numWords64; i++ {
+		binary.LittleEndian.PutUint64(b[i*8:(i+1)*8], S[i])
+	}
+	s[Size-1] = 0
+	copy(s[:Size-1], b[:numWords64*8])
+}
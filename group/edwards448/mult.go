@@ -1,4 +1,4 @@
-package ed25519
+package edwards448
 
 import (
 	"crypto/subtle"
@@ -7,19 +7,11 @@ import (
 
 	"github.com/cloudflare/circl/internal/conv"
 	"github.com/cloudflare/circl/math"
-	fp "github.com/cloudflare/circl/math/fp25519"
 )
 
-var paramD = fp.Elt{
-	0xa3, 0x78, 0x59, 0x13, 0xca, 0x4d, 0xeb, 0x75,
-	0xab, 0xd8, 0x41, 0x41, 0x4d, 0x0a, 0x70, 0x00,
-	0x98, 0xe8, 0x79, 0x77, 0x79, 0x40, 0xc7, 0x8c,
-	0x73, 0xfe, 0x6f, 0x2b, 0xee, 0x6c, 0x03, 0x52,
-}
-
 // mLSBRecoding parameters
 const (
-	fxT        = 257
+	fxT        = 448
 	fxV        = 2
 	fxW        = 3
 	fx2w1      = 1 << (uint(fxW) - 1)
@@ -136,6 +128,75 @@ func (P *pointR1) fixedMult(scalar []byte) {
 	}
 }
 
+// scalarDigits is the number of radix-16 digits of a scalar.
+const scalarDigits = (fxT + 3) / 4
+
+// recodeScalar calculates a signed-digit recoding of an odd scalar k
+// such that k = sum(d[i]*16^i), and each d[i] is an odd number in the set
+// {±1, ±3, ..., ±15}. The scalar k must be less than 2^fxT.
+func recodeScalar(d *[scalarDigits + 1]int8, k *[numWords64 + 1]uint64) {
+	for i := 0; i < scalarDigits; i++ {
+		d[i] = int8((k[0] & 0x1f) - 16)
+		subYDiv16(k, int64(d[i]))
+	}
+	d[scalarDigits] = int8(k[0])
+}
+
+// subYDiv16 update x = (x - y) / 16.
+func subYDiv16(x *[numWords64 + 1]uint64, y int64) {
+	s := uint64(y >> 63)
+	var b uint64
+	x[0], b = bits.Sub64(x[0], uint64(y), 0)
+	for i := 1; i < len(x); i++ {
+		x[i], b = bits.Sub64(x[i], s, b)
+	}
+	for i := 0; i < len(x)-1; i++ {
+		x[i] = (x[i] >> 4) | (x[i+1] << 60)
+	}
+	x[len(x)-1] = x[len(x)-1] >> 4
+}
+
+// scalarMult calculates P = kQ in constant time. It works for any point Q,
+// even if it is not in the prime-order subgroup. Q is modified.
+func (P *pointR1) scalarMult(k []byte, Q *pointR1) {
+	if len(k) != Size {
+		panic("wrong scalar size")
+	}
+	var m [numWords64 + 1]uint64
+	for i := 0; i < numWords64; i++ {
+		m[i] = binary.LittleEndian.Uint64(k[8*i : 8*i+8])
+	}
+	// If k is even, it calculates (k+1)Q-Q, since the recoding only
+	// works for odd scalars.
+	isEven := int(1 - (m[0] & 0x1))
+	m[0] |= 1
+
+	var negQ, S pointR2
+	negQ.fromR1(Q)
+	negQ.neg()
+
+	var TabQ [8]pointR2 // odd multiples 1Q, 3Q, ..., 15Q
+	var d [scalarDigits + 1]int8
+	Q.oddMultiples(TabQ[:])
+	recodeScalar(&d, &m)
+	P.SetIdentity()
+	for i := scalarDigits; i >= 0; i-- {
+		P.double()
+		P.double()
+		P.double()
+		P.double()
+		idx := absolute(int32(d[i])) >> 1
+		for j := range TabQ {
+			S.cmov(&TabQ[j], subtle.ConstantTimeEq(int32(j), idx))
+		}
+		S.cneg(subtle.ConstantTimeEq(int32(d[i]>>7), -1))
+		P.add(&S)
+	}
+	S.SetIdentity()
+	S.cmov(&negQ, isEven)
+	P.add(&S)
+}
+
 const (
 	omegaFix = 7
 	omegaVar = 5
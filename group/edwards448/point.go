@@ -0,0 +1,219 @@
+package edwards448
+
+import fp "github.com/cloudflare/circl/math/fp448"
+
+// paramD is the parameter d = -39081 of the Edwards448 curve.
+var paramD = fp.Elt{
+	0x56, 0x67, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xfe, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+}
+
+type pointR1 struct{ x, y, z, ta, tb fp.Elt }
+type pointR2 struct {
+	pointR3
+	z fp.Elt
+}
+type pointR3 struct{ x, y, dt fp.Elt }
+
+func (P *pointR1) neg() {
+	fp.Neg(&P.x, &P.x)
+	fp.Neg(&P.ta, &P.ta)
+}
+
+func (P *pointR1) SetIdentity() {
+	P.x = fp.Elt{}
+	fp.SetOne(&P.y)
+	fp.SetOne(&P.z)
+	P.ta = fp.Elt{}
+	P.tb = fp.Elt{}
+}
+
+func (P *pointR1) toAffine() {
+	fp.Inv(&P.z, &P.z)
+	fp.Mul(&P.x, &P.x, &P.z)
+	fp.Mul(&P.y, &P.y, &P.z)
+	fp.Modp(&P.x)
+	fp.Modp(&P.y)
+	fp.SetOne(&P.z)
+	P.ta = P.x
+	P.tb = P.y
+}
+
+func (P *pointR1) ToBytes(k []byte) {
+	P.toAffine()
+	var x [fp.Size]byte
+	fp.ToBytes(k[:fp.Size], &P.y)
+	fp.ToBytes(x[:], &P.x)
+	b := x[0] & 1
+	k[Size-1] = b << 7
+}
+
+func (P *pointR1) FromBytes(k []byte) bool {
+	if len(k) != Size {
+		panic("wrong size")
+	}
+	signX := k[Size-1] >> 7
+	if k[Size-1]&0x7F != 0 {
+		return false
+	}
+	copy(P.y[:], k[:fp.Size])
+	p := fp.P()
+	if !isLessThan(P.y[:], p[:]) {
+		return false
+	}
+
+	one, u, v := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+	fp.SetOne(one)
+	fp.Sqr(u, &P.y)                // u = y^2
+	fp.Mul(v, u, &paramD)          // v = dy^2
+	fp.Sub(u, u, one)              // u = y^2-1
+	fp.Sub(v, v, one)              // v = dy^2-1
+	isQR := fp.InvSqrt(&P.x, u, v) // x = sqrt(u/v)
+	if !isQR {
+		return false
+	}
+	fp.Modp(&P.x) // x = x mod p
+	if fp.IsZero(&P.x) && signX == 1 {
+		return false
+	}
+	if signX != (P.x[0] & 1) {
+		fp.Neg(&P.x, &P.x)
+	}
+	P.ta = P.x
+	P.tb = P.y
+	fp.SetOne(&P.z)
+	return true
+}
+
+// double calculates 2P for curves with A=1
+func (P *pointR1) double() {
+	Px, Py, Pz, Pta, Ptb := &P.x, &P.y, &P.z, &P.ta, &P.tb
+	a, b, c, e, f, g, h := Px, Py, Pz, Pta, Px, Py, Ptb
+	fp.Add(e, Px, Py) // x+y
+	fp.Sqr(a, Px)     // A = x^2
+	fp.Sqr(b, Py)     // B = y^2
+	fp.Sqr(c, Pz)     // z^2
+	fp.Add(c, c, c)   // C = 2*z^2
+	fp.Sub(h, a, b)   // H = A-B
+	fp.Add(g, a, b)   // G = A+B
+	fp.Sqr(e, e)      // (x+y)^2
+	fp.Sub(e, e, g)   // E = (x+y)^2-A-B
+	fp.Sub(f, g, c)   // F = G-C
+	fp.Mul(Pz, f, g)  // Z = F * G
+	fp.Mul(Px, e, f)  // X = E * F
+	fp.Mul(Py, g, h)  // Y = G * H, T = E * H
+}
+
+func (P *pointR1) mixAdd(Q *pointR3) {
+	P.coreAddition(Q) // D = z1
+}
+
+func (P *pointR1) add(Q *pointR2) {
+	fp.Mul(&P.z, &P.z, &Q.z) // D = z1*z2
+	P.coreAddition(&Q.pointR3)
+}
+
+// coreAddition calculates P=P+Q for curves with A=1
+func (P *pointR1) coreAddition(Q *pointR3) {
+	Px, Py, Pz, Pta, Ptb := &P.x, &P.y, &P.z, &P.ta, &P.tb
+	x2, y2, dt2 := &Q.x, &Q.y, &Q.dt
+	a, b, c, d, e, f, g, h := Px, Py, &fp.Elt{}, Pz, Pta, Px, Py, Ptb
+	s := &fp.Elt{}
+	fp.Mul(c, Pta, Ptb) // t1 = ta*tb
+	fp.Add(h, Px, Py)   // x1+y1
+	fp.Add(s, x2, y2)   // x2+y2
+	fp.Mul(e, h, s)     // (x1+y1)*(x2+y2)
+	fp.Mul(a, Px, x2)   // A = x1*x2
+	fp.Mul(b, Py, y2)   // B = y1*y2
+	fp.Mul(c, c, dt2)   // C = d*t1*t2
+	fp.Sub(e, e, a)     // (x1+y1)*(x2+y2)-A
+	fp.Sub(h, b, a)     // H = B-A
+	fp.Sub(e, e, b)     // E = (x1+y1)*(x2+y2)-A-B
+	fp.Sub(f, d, c)     // F = D-C
+	fp.Add(g, d, c)     // G = D+C
+	fp.Mul(Pz, f, g)    // Z = F * G
+	fp.Mul(Px, e, f)    // X = E * F
+	fp.Mul(Py, g, h)    // Y = G * H, T = E * H
+}
+
+func (P *pointR1) oddMultiples(T []pointR2) {
+	var R pointR2
+	n := len(T)
+	T[0].fromR1(P)
+	_2P := *P
+	_2P.double()
+	R.fromR1(&_2P)
+	for i := 1; i < n; i++ {
+		P.add(&R)
+		T[i].fromR1(P)
+	}
+}
+
+func (P *pointR1) isIdentity() bool {
+	t := &fp.Elt{}
+	fp.Sub(t, &P.y, &P.z)
+	return fp.IsZero(&P.x) && fp.IsZero(t)
+}
+
+func (P *pointR1) isEqual(Q *pointR1) bool {
+	l, r := &fp.Elt{}, &fp.Elt{}
+	fp.Mul(l, &P.x, &Q.z)
+	fp.Mul(r, &Q.x, &P.z)
+	fp.Sub(l, l, r)
+	b := fp.IsZero(l)
+	fp.Mul(l, &P.y, &Q.z)
+	fp.Mul(r, &Q.y, &P.z)
+	fp.Sub(l, l, r)
+	b = b && fp.IsZero(l)
+	fp.Mul(l, &P.ta, &P.tb)
+	fp.Mul(l, l, &Q.z)
+	fp.Mul(r, &Q.ta, &Q.tb)
+	fp.Mul(r, r, &P.z)
+	fp.Sub(l, l, r)
+	b = b && fp.IsZero(l)
+	return b
+}
+
+func (P *pointR3) neg() {
+	fp.Neg(&P.x, &P.x)
+	fp.Neg(&P.dt, &P.dt)
+}
+
+func (P *pointR2) fromR1(Q *pointR1) {
+	P.x = Q.x
+	P.y = Q.y
+	fp.Mul(&P.dt, &Q.ta, &Q.tb)
+	fp.Mul(&P.dt, &P.dt, &paramD)
+	P.z = Q.z
+}
+
+func (P *pointR3) cneg(b int) {
+	t := &fp.Elt{}
+	fp.Neg(t, &P.x)
+	fp.Cmov(&P.x, t, uint(b))
+	fp.Neg(t, &P.dt)
+	fp.Cmov(&P.dt, t, uint(b))
+}
+
+func (P *pointR2) SetIdentity() {
+	P.x = fp.Elt{}
+	fp.SetOne(&P.y)
+	P.dt = fp.Elt{}
+	fp.SetOne(&P.z)
+}
+
+func (P *pointR2) cmov(Q *pointR2, b int) {
+	P.pointR3.cmov(&Q.pointR3, b)
+	fp.Cmov(&P.z, &Q.z, uint(b))
+}
+
+func (P *pointR3) cmov(Q *pointR3, b int) {
+	fp.Cmov(&P.x, &Q.x, uint(b))
+	fp.Cmov(&P.y, &Q.y, uint(b))
+	fp.Cmov(&P.dt, &Q.dt, uint(b))
+}
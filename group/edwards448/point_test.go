@@ -1,4 +1,4 @@
-package ed25519
+package edwards448
 
 import (
 	"crypto/rand"
@@ -11,6 +11,7 @@ import (
 func randomPoint(P *pointR1) {
 	k := make([]byte, Size)
 	_, _ = rand.Read(k[:])
+	reduceModOrder(k)
 	P.fixedMult(k)
 }
 
@@ -50,6 +51,7 @@ func TestPoint(t *testing.T) {
 		for i := 0; i < testTimes; i++ {
 			randomPoint(&P)
 			_, _ = rand.Read(k[:])
+			reduceModOrder(k)
 
 			Q.fixedMult(k[:])
 			R.doubleMult(&P, k[:], l[:])
@@ -75,6 +77,8 @@ func BenchmarkPoint(b *testing.B) {
 	l := make([]byte, Size)
 	_, _ = rand.Read(k)
 	_, _ = rand.Read(l)
+	reduceModOrder(k)
+	reduceModOrder(l)
 
 	var P pointR1
 	var Q pointR2
@@ -0,0 +1,130 @@
+package edwards448
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"io"
+	"math/bits"
+)
+
+// Scalar is an integer modulo the order of the prime-order subgroup,
+// L = 2^446 - 13818066809895115352007386748515426880336692474882178609894547503885.
+// Scalars are always reduced modulo L. The zero value is the scalar 0.
+type Scalar struct {
+	// k has an extra byte, which is always zero, so scalars can be passed
+	// to functions that operate on Size-byte integers.
+	k [Size]byte
+}
+
+// orderMinusOne is L-1, which is congruent to -1 modulo L.
+var orderMinusOne = [Size]byte{
+	0xf2, 0x44, 0x58, 0xab, 0x92, 0xc2, 0x78, 0x23,
+	0x55, 0x8f, 0xc5, 0x8d, 0x72, 0xc2, 0x6c, 0x21,
+	0x90, 0x36, 0xd6, 0xae, 0x49, 0xdb, 0x4e, 0xc4,
+	0xe9, 0x23, 0xca, 0x7c, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x3f,
+	0x00,
+}
+
+// SetUint64 sets z = x.
+func (z *Scalar) SetUint64(x uint64) {
+	z.k = [Size]byte{}
+	binary.LittleEndian.PutUint64(z.k[:8], x)
+}
+
+// IsZero returns true if z = 0.
+func (z *Scalar) IsZero() bool { return z.IsEqual(&Scalar{}) }
+
+// IsEqual returns true if z = x.
+func (z *Scalar) IsEqual(x *Scalar) bool {
+	return subtle.ConstantTimeCompare(z.k[:], x.k[:]) == 1
+}
+
+// Add calculates z = x + y mod L.
+func (z *Scalar) Add(x, y *Scalar) {
+	var one Scalar
+	one.SetUint64(1)
+	calculateS(z.k[:], x.k[:], one.k[:], y.k[:])
+}
+
+// Sub calculates z = x - y mod L.
+func (z *Scalar) Sub(x, y *Scalar) { calculateS(z.k[:], x.k[:], orderMinusOne[:], y.k[:]) }
+
+// Neg calculates z = -x mod L.
+func (z *Scalar) Neg(x *Scalar) {
+	var zero Scalar
+	calculateS(z.k[:], zero.k[:], orderMinusOne[:], x.k[:])
+}
+
+// Mul calculates z = x * y mod L.
+func (z *Scalar) Mul(x, y *Scalar) {
+	var zero Scalar
+	calculateS(z.k[:], zero.k[:], x.k[:], y.k[:])
+}
+
+// MulAdd calculates z = x * y + w mod L.
+func (z *Scalar) MulAdd(x, y, w *Scalar) { calculateS(z.k[:], w.k[:], x.k[:], y.k[:]) }
+
+// Inv calculates z = 1/x mod L using Fermat's little theorem, so z = 0 if
+// x = 0.
+func (z *Scalar) Inv(x *Scalar) {
+	// Since the exponent L-2 is public, the sequence of operations does
+	// not depend on x.
+	var exp [Size]byte
+	copy(exp[:], orderMinusOne[:])
+	exp[0]--
+	var t Scalar
+	t.SetUint64(1)
+	xx := *x
+	for i := 8*ScalarSize - 1; i >= 0; i-- {
+		t.Mul(&t, &t)
+		if (exp[i/8]>>uint(i%8))&1 == 1 {
+			t.Mul(&t, &xx)
+		}
+	}
+	*z = t
+}
+
+// SetBytesWide sets z = in mod L, where in is a 114-byte integer encoded
+// in little-endian order, such as the output of SHAKE256 used by Ed448.
+func (z *Scalar) SetBytesWide(in *[2 * Size]byte) {
+	var k [2 * Size]byte
+	copy(k[:], in[:])
+	reduceModOrder(k[:])
+	copy(z.k[:], k[:Size])
+}
+
+// Random sets z to a uniformly random scalar, using entropy from rnd.
+func (z *Scalar) Random(rnd io.Reader) error {
+	var k [2 * Size]byte
+	if _, err := io.ReadFull(rnd, k[:]); err != nil {
+		return err
+	}
+	z.SetBytesWide(&k)
+	return nil
+}
+
+// Marshal stores z in out as a 56-byte integer in little-endian order.
+func (z *Scalar) Marshal(out *[ScalarSize]byte) { copy(out[:], z.k[:ScalarSize]) }
+
+// Unmarshal sets z to the integer encoded in little-endian order in the
+// input, and returns false if it is not less than L. In that case, z is
+// not modified.
+func (z *Scalar) Unmarshal(in *[ScalarSize]byte) bool {
+	// Computes in - L in constant time; in < L if and only if there is a
+	// borrow.
+	var borrow uint64
+	for i := 0; i < numWords64; i++ {
+		x := binary.LittleEndian.Uint64(in[8*i : 8*i+8])
+		l := binary.LittleEndian.Uint64(order[8*i : 8*i+8])
+		_, borrow = bits.Sub64(x, l, borrow)
+	}
+	if borrow == 0 {
+		return false
+	}
+	copy(z.k[:ScalarSize], in[:])
+	z.k[ScalarSize] = 0
+	return true
+}
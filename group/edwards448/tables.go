@@ -0,0 +1,213 @@
+package edwards448
+
+import fp "github.com/cloudflare/circl/math/fp448"
+
+var tabSign = [fxV][fx2w1]pointR3{
+	{
+		{
+			x:  fp.Elt{0x5e, 0xc0, 0x0c, 0xc7, 0x2b, 0xa8, 0x26, 0x26, 0x8e, 0x93, 0x00, 0x8b, 0xe1, 0x80, 0x3b, 0x43, 0x11, 0x65, 0xb6, 0x2a, 0xf7, 0x1a, 0xae, 0x12, 0x64, 0xa4, 0xd3, 0xa3, 0x24, 0xe3, 0x6d, 0xea, 0x67, 0x17, 0x0f, 0x47, 0x70, 0x65, 0x14, 0x9e, 0xda, 0x36, 0xbf, 0x22, 0xa6, 0x15, 0x1d, 0x22, 0xed, 0x0d, 0xed, 0x6b, 0xc6, 0x70, 0x19, 0x4f},
+			y:  fp.Elt{0x14, 0xfa, 0x30, 0xf2, 0x5b, 0x79, 0x08, 0x98, 0xad, 0xc8, 0xd7, 0x4e, 0x2c, 0x13, 0xbd, 0xfd, 0xc4, 0x39, 0x7c, 0xe6, 0x1c, 0xff, 0xd3, 0x3a, 0xd7, 0xc2, 0xa0, 0x05, 0x1e, 0x9c, 0x78, 0x87, 0x40, 0x98, 0xa3, 0x6c, 0x73, 0x73, 0xea, 0x4b, 0x62, 0xc7, 0xc9, 0x56, 0x37, 0x20, 0x76, 0x88, 0x24, 0xbc, 0xb6, 0x6e, 0x71, 0x46, 0x3f, 0x69},
+			dt: fp.Elt{0xb1, 0x43, 0x0d, 0x79, 0x93, 0x7c, 0x6a, 0x0e, 0xb4, 0x73, 0xc2, 0x44, 0xee, 0x85, 0xe4, 0x4a, 0x16, 0xe5, 0x92, 0x12, 0x96, 0x26, 0x66, 0x3a, 0xe8, 0x00, 0xce, 0xf1, 0x37, 0x10, 0xb4, 0x5c, 0x9e, 0x12, 0x11, 0x81, 0x47, 0x90, 0x6e, 0xb8, 0xd1, 0xf4, 0xfa, 0xc3, 0x41, 0x55, 0x8a, 0x5b, 0x67, 0xa1, 0x9a, 0x8a, 0xe4, 0xa9, 0xaf, 0x26},
+		},
+		{
+			x:  fp.Elt{0x54, 0x3a, 0x1d, 0x4a, 0x81, 0xeb, 0x51, 0x33, 0xf4, 0x5d, 0x85, 0xe8, 0xb5, 0xa9, 0x49, 0xd6, 0x5d, 0x30, 0x70, 0xc4, 0x7a, 0xa8, 0x14, 0xa1, 0x89, 0xc1, 0x2d, 0x18, 0x94, 0xc6, 0xc4, 0xc0, 0x17, 0x6a, 0x96, 0xf2, 0x6e, 0x48, 0x3b, 0x81, 0xa4, 0x43, 0xfe, 0x71, 0x6f, 0x30, 0x3c, 0xfc, 0x3d, 0xaf, 0x38, 0x40, 0x89, 0x21, 0x56, 0x04},
+			y:  fp.Elt{0xed, 0x5d, 0x13, 0xe5, 0x65, 0x63, 0x3d, 0xa4, 0x86, 0x9b, 0x00, 0xb0, 0x85, 0x6d, 0xa5, 0xa6, 0xa2, 0x31, 0x08, 0xcc, 0x4a, 0xc0, 0x37, 0xa7, 0xc4, 0x4f, 0x5d, 0xaa, 0xfc, 0xd1, 0xcd, 0x93, 0xb2, 0xc1, 0x68, 0x26, 0xdd, 0xd1, 0x3c, 0x9f, 0x0b, 0x76, 0xfd, 0xdc, 0x08, 0x07, 0xce, 0xb1, 0x05, 0x36, 0xaf, 0x91, 0xc5, 0xe2, 0x7c, 0x8b},
+			dt: fp.Elt{0x4c, 0x48, 0x00, 0x6a, 0x45, 0x26, 0x17, 0x50, 0xc3, 0x4f, 0x3f, 0x85, 0x11, 0x56, 0x65, 0xc8, 0x6f, 0x64, 0xf9, 0x6b, 0x89, 0x88, 0xb3, 0x84, 0x62, 0xae, 0x8e, 0x2f, 0xa4, 0x04, 0xf8, 0xa9, 0x4a, 0x40, 0x51, 0xe4, 0x31, 0x9a, 0x62, 0xca, 0x2c, 0x09, 0xa5, 0x51, 0xf3, 0x02, 0x74, 0xc5, 0x21, 0x62, 0x96, 0xcd, 0xc2, 0x2e, 0x23, 0x4d},
+		},
+		{
+			x:  fp.Elt{0xbe, 0xb2, 0x4e, 0x29, 0x38, 0xc8, 0x66, 0xf7, 0x33, 0xbe, 0x3b, 0x78, 0x02, 0xea, 0x3f, 0x22, 0x87, 0xf1, 0x10, 0x00, 0x07, 0x72, 0x9f, 0x8b, 0xd1, 0x99, 0x2a, 0x1d, 0x7f, 0x70, 0x6d, 0xb4, 0x40, 0x65, 0x53, 0xa3, 0x5b, 0x10, 0xf6, 0xb5, 0x89, 0xb8, 0xba, 0x53, 0xe8, 0xe1, 0xee, 0xba, 0x7d, 0xf6, 0x85, 0x24, 0x73, 0xc1, 0x25, 0x71},
+			y:  fp.Elt{0x3b, 0x32, 0x21, 0x2a, 0xfc, 0x57, 0xc5, 0xac, 0x06, 0xbe, 0x98, 0x20, 0xbc, 0xd1, 0x04, 0x30, 0xf8, 0xa3, 0xd4, 0xec, 0xbe, 0xa0, 0xb9, 0xf7, 0x1f, 0x7e, 0xa5, 0x1a, 0x15, 0x88, 0x3e, 0x41, 0xbf, 0xf3, 0xb9, 0x10, 0x67, 0x10, 0x5a, 0x75, 0xa6, 0x74, 0x6c, 0xf2, 0xe5, 0x21, 0xe1, 0x3f, 0x1a, 0x41, 0x4b, 0xdc, 0x54, 0x54, 0x70, 0xcc},
+			dt: fp.Elt{0x06, 0x38, 0xf5, 0x90, 0x2b, 0x23, 0x03, 0x97, 0xa5, 0x90, 0xc4, 0xe9, 0xd1, 0xf4, 0xa7, 0xe6, 0xfa, 0xec, 0xe9, 0x14, 0x4c, 0x39, 0xcf, 0x03, 0x73, 0xd5, 0xd5, 0xf8, 0xd0, 0x47, 0x14, 0x17, 0xd8, 0x07, 0x7d, 0x12, 0xb4, 0x01, 0x5f, 0xfd, 0x6c, 0x81, 0x33, 0xba, 0x14, 0x1e, 0x69, 0x29, 0xe5, 0x07, 0xdb, 0xda, 0x16, 0xea, 0x41, 0xd7},
+		},
+		{
+			x:  fp.Elt{0x95, 0xa7, 0x4a, 0x39, 0x11, 0x85, 0x75, 0xd1, 0x25, 0x74, 0x08, 0xf8, 0xc4, 0x9c, 0xee, 0x04, 0x97, 0xe4, 0xc6, 0x68, 0x71, 0x28, 0xfc, 0x61, 0x27, 0x8b, 0x42, 0x5c, 0x8f, 0xab, 0x51, 0x32, 0x6d, 0xd4, 0x8a, 0xc7, 0x5e, 0x5c, 0xf5, 0xa5, 0x1e, 0x20, 0x1e, 0xb7, 0xe9, 0xbd, 0x12, 0x40, 0x97, 0x0b, 0xaf, 0xf5, 0xd2, 0x2f, 0x10, 0x29},
+			y:  fp.Elt{0xe1, 0x43, 0x11, 0x3f, 0xc2, 0x77, 0xbf, 0xac, 0x4c, 0x0c, 0xbf, 0xc2, 0x22, 0x2e, 0xc5, 0xb5, 0x26, 0xad, 0x40, 0x72, 0x19, 0xa5, 0x00, 0x9e, 0x54, 0xab, 0x7b, 0x34, 0x67, 0x94, 0x67, 0xd6, 0x70, 0x91, 0x9c, 0x50, 0xef, 0x4b, 0x69, 0x92, 0xda, 0x26, 0xab, 0x69, 0x8a, 0x9c, 0x96, 0xc6, 0x31, 0x97, 0x3e, 0x2d, 0x3b, 0x9a, 0x90, 0xbf},
+			dt: fp.Elt{0x69, 0xa3, 0x41, 0xbd, 0x54, 0x48, 0x8c, 0x4f, 0x5d, 0xae, 0xa0, 0xd0, 0xa2, 0x97, 0x11, 0xdd, 0x66, 0x57, 0xfe, 0xcd, 0x5e, 0x2b, 0x26, 0x6a, 0xc1, 0x70, 0xe2, 0xbb, 0xd4, 0xf0, 0xee, 0x45, 0xa7, 0x83, 0x3f, 0x2d, 0xdf, 0x4c, 0x81, 0x98, 0x13, 0x10, 0x61, 0x04, 0x68, 0x0e, 0xf5, 0x9f, 0x26, 0x91, 0x87, 0xe3, 0x55, 0x77, 0xe1, 0x8f},
+		},
+	},
+	{
+		{
+			x:  fp.Elt{0x09, 0x97, 0x7e, 0xd7, 0xe1, 0x4a, 0xd2, 0x8a, 0x7b, 0x04, 0xc0, 0x1d, 0x75, 0x77, 0x93, 0x15, 0x6a, 0x4c, 0x33, 0x25, 0xe3, 0x6a, 0xf8, 0x71, 0x26, 0x96, 0xaf, 0x9b, 0x5e, 0xa1, 0x29, 0xac, 0xf6, 0x5a, 0x42, 0x33, 0x6e, 0x79, 0x02, 0x60, 0x08, 0x31, 0xa5, 0x53, 0xc2, 0xcf, 0x78, 0xea, 0xb6, 0xea, 0xe0, 0xfa, 0x0a, 0x3e, 0x73, 0x4c},
+			y:  fp.Elt{0xb9, 0x99, 0x7c, 0xa9, 0x43, 0x74, 0x4b, 0xa6, 0x03, 0x02, 0x45, 0x9e, 0x4e, 0xc1, 0xba, 0x80, 0x26, 0x55, 0x51, 0xbb, 0xd1, 0x3a, 0x53, 0x55, 0xfd, 0x3e, 0x6a, 0xa5, 0xa0, 0xe1, 0x69, 0xc1, 0x38, 0x6e, 0xa6, 0xa0, 0x7d, 0xed, 0x9e, 0xdf, 0xe4, 0xb3, 0xd9, 0xe3, 0xdc, 0x7d, 0x93, 0x2c, 0x02, 0xb4, 0x36, 0x6e, 0x9f, 0x08, 0x52, 0x85},
+			dt: fp.Elt{0xa9, 0xf0, 0x31, 0x37, 0xaa, 0x05, 0x81, 0x05, 0x8b, 0x94, 0xd9, 0x2d, 0x79, 0xa5, 0xff, 0x9f, 0xa1, 0x26, 0x1b, 0xb8, 0xfb, 0xc6, 0xd2, 0xf0, 0xe2, 0x11, 0xff, 0x92, 0x37, 0x9b, 0xdb, 0xfa, 0x3b, 0x05, 0x58, 0xf2, 0xcc, 0xc1, 0x69, 0xb7, 0xe7, 0x01, 0x41, 0x63, 0x7c, 0x5e, 0xfd, 0x73, 0x3b, 0x85, 0x91, 0x3a, 0x9e, 0x88, 0xc5, 0x7a},
+		},
+		{
+			x:  fp.Elt{0xec, 0x3d, 0x5b, 0x20, 0x66, 0x55, 0x8b, 0x4c, 0xbd, 0xa5, 0x47, 0x56, 0xeb, 0xdb, 0x55, 0x42, 0x39, 0x5f, 0x3c, 0x15, 0x73, 0x27, 0x58, 0xe4, 0x3a, 0x24, 0x4c, 0x62, 0xf8, 0x77, 0xc6, 0x64, 0x6c, 0x5c, 0x3d, 0x17, 0xca, 0x18, 0xa8, 0x6c, 0x2b, 0xb8, 0x23, 0x54, 0x52, 0x3c, 0x1e, 0xc9, 0x3a, 0xe8, 0x14, 0x48, 0x3c, 0x5f, 0x23, 0x27},
+			y:  fp.Elt{0xf9, 0xe7, 0x38, 0x86, 0x87, 0xf8, 0xeb, 0x21, 0x29, 0x9f, 0x15, 0xed, 0x02, 0xed, 0x16, 0xd1, 0x08, 0x5d, 0xd7, 0x3c, 0x83, 0x82, 0xde, 0xd1, 0x4e, 0x01, 0x5d, 0x27, 0x84, 0x3f, 0xd3, 0x86, 0x96, 0x4e, 0xdb, 0xcb, 0x55, 0x9b, 0xa8, 0xee, 0xe8, 0x76, 0xe2, 0x72, 0x70, 0x7d, 0xa1, 0xd0, 0xc9, 0x95, 0x62, 0xd1, 0x75, 0x3d, 0xf1, 0x93},
+			dt: fp.Elt{0x81, 0xd0, 0x42, 0x94, 0xb3, 0xe3, 0x12, 0x88, 0x6a, 0x71, 0x77, 0xfd, 0x27, 0x40, 0x51, 0xfd, 0x7c, 0x81, 0x73, 0x8e, 0x55, 0xca, 0x98, 0x76, 0x81, 0xeb, 0x63, 0x92, 0x23, 0xc6, 0x5b, 0xec, 0xcf, 0x71, 0x3a, 0x93, 0xdf, 0x68, 0x33, 0xc3, 0x4d, 0x03, 0xbb, 0x01, 0xae, 0x71, 0xe9, 0xc3, 0xdd, 0x48, 0x4a, 0x29, 0xfc, 0x84, 0xbd, 0xa6},
+		},
+		{
+			x:  fp.Elt{0xce, 0xfb, 0x30, 0x5b, 0xb2, 0x86, 0x83, 0x53, 0x2c, 0xe9, 0x33, 0x13, 0x12, 0xbe, 0xae, 0x9b, 0xa6, 0x0f, 0xea, 0x59, 0x19, 0x57, 0x63, 0x7f, 0x2b, 0xa7, 0xbb, 0xe5, 0xf1, 0xc6, 0xf1, 0x03, 0x97, 0xa7, 0xb4, 0x87, 0x0c, 0x9f, 0x02, 0x2f, 0xa4, 0xf7, 0xf4, 0xc4, 0x4e, 0x9a, 0x1a, 0xe7, 0x5f, 0xa5, 0xe7, 0x81, 0xfe, 0xa2, 0x69, 0xbc},
+			y:  fp.Elt{0x22, 0x0a, 0x96, 0xf5, 0xf3, 0x06, 0xf8, 0xcf, 0xbe, 0x5c, 0x9f, 0x8e, 0x3c, 0x34, 0xeb, 0x32, 0xad, 0x55, 0x63, 0x61, 0x74, 0x84, 0x90, 0xd1, 0x33, 0x12, 0xaf, 0x3f, 0x7c, 0xc8, 0xd0, 0xb2, 0xfb, 0xee, 0x65, 0x41, 0xa9, 0x61, 0x35, 0x4b, 0xf2, 0x88, 0xae, 0xb9, 0x5e, 0xa4, 0xc6, 0x48, 0x9e, 0x50, 0x8b, 0xcb, 0x33, 0x79, 0x61, 0x58},
+			dt: fp.Elt{0xa8, 0x6a, 0xfb, 0x9b, 0xfc, 0x8f, 0x44, 0xaa, 0x43, 0xab, 0xf9, 0x81, 0x14, 0xac, 0xa1, 0xba, 0x90, 0xf9, 0x59, 0x93, 0xbd, 0x5a, 0x9c, 0x1e, 0xf1, 0x47, 0x48, 0xc9, 0x2b, 0xa0, 0xa6, 0xda, 0xe1, 0xb2, 0x45, 0x7e, 0xed, 0x5e, 0x20, 0xf5, 0x1a, 0x51, 0x11, 0x5d, 0xc0, 0x75, 0x19, 0x8b, 0x07, 0x1d, 0xc0, 0x91, 0x16, 0x4b, 0x6e, 0x03},
+		},
+		{
+			x:  fp.Elt{0x61, 0x42, 0xf0, 0x58, 0x6a, 0x91, 0x4a, 0x4c, 0x1f, 0xa6, 0x06, 0x1d, 0x6f, 0xd4, 0xcc, 0x0a, 0xfb, 0xcc, 0xd7, 0xbb, 0xf6, 0xb6, 0x7b, 0xaf, 0xa6, 0x36, 0x45, 0x61, 0xc8, 0xe3, 0xf7, 0x71, 0xea, 0xf7, 0xf6, 0xda, 0xd5, 0x58, 0xa1, 0x5d, 0x8c, 0xaa, 0x4d, 0xf9, 0x56, 0xb9, 0x87, 0x17, 0x23, 0xe7, 0xc5, 0xca, 0x66, 0xb7, 0xa3, 0xaf},
+			y:  fp.Elt{0x73, 0x1d, 0x68, 0xc8, 0x2c, 0x4d, 0xaf, 0x66, 0x54, 0x1e, 0x7a, 0xd8, 0x0a, 0xe3, 0xf8, 0xd8, 0xd0, 0x35, 0xa9, 0xae, 0xf1, 0xc9, 0xea, 0x4c, 0x4e, 0xb1, 0x6f, 0x7c, 0x5e, 0x9e, 0x9e, 0xe1, 0x4e, 0xab, 0x7a, 0x4a, 0x68, 0x30, 0xf5, 0xfb, 0xd2, 0xe6, 0xce, 0xcb, 0xf7, 0x78, 0x82, 0xe4, 0x1a, 0x60, 0x5e, 0x10, 0xb9, 0xb9, 0x8a, 0xd3},
+			dt: fp.Elt{0xb4, 0xe2, 0x06, 0x81, 0x88, 0x6a, 0xd7, 0x24, 0xa9, 0xa0, 0x34, 0xb9, 0xba, 0x22, 0xd6, 0x36, 0x50, 0x01, 0xea, 0xc8, 0xc1, 0xb4, 0x42, 0xd2, 0xd2, 0x2b, 0x04, 0x89, 0x55, 0x6a, 0xc0, 0x44, 0x61, 0x9e, 0x1d, 0xf2, 0x79, 0x4c, 0xf7, 0x23, 0x8a, 0x4a, 0x47, 0xfa, 0xe4, 0x52, 0x32, 0xba, 0x4a, 0x88, 0x01, 0xdb, 0x60, 0x40, 0x34, 0x17},
+		},
+	},
+}
+
+var tabVerif = [1 << (omegaFix - 2)]pointR3{
+	{ /* 1P */
+		x:  fp.Elt{0x5e, 0xc0, 0x0c, 0xc7, 0x2b, 0xa8, 0x26, 0x26, 0x8e, 0x93, 0x00, 0x8b, 0xe1, 0x80, 0x3b, 0x43, 0x11, 0x65, 0xb6, 0x2a, 0xf7, 0x1a, 0xae, 0x12, 0x64, 0xa4, 0xd3, 0xa3, 0x24, 0xe3, 0x6d, 0xea, 0x67, 0x17, 0x0f, 0x47, 0x70, 0x65, 0x14, 0x9e, 0xda, 0x36, 0xbf, 0x22, 0xa6, 0x15, 0x1d, 0x22, 0xed, 0x0d, 0xed, 0x6b, 0xc6, 0x70, 0x19, 0x4f},
+		y:  fp.Elt{0x14, 0xfa, 0x30, 0xf2, 0x5b, 0x79, 0x08, 0x98, 0xad, 0xc8, 0xd7, 0x4e, 0x2c, 0x13, 0xbd, 0xfd, 0xc4, 0x39, 0x7c, 0xe6, 0x1c, 0xff, 0xd3, 0x3a, 0xd7, 0xc2, 0xa0, 0x05, 0x1e, 0x9c, 0x78, 0x87, 0x40, 0x98, 0xa3, 0x6c, 0x73, 0x73, 0xea, 0x4b, 0x62, 0xc7, 0xc9, 0x56, 0x37, 0x20, 0x76, 0x88, 0x24, 0xbc, 0xb6, 0x6e, 0x71, 0x46, 0x3f, 0x69},
+		dt: fp.Elt{0xb1, 0x43, 0x0d, 0x79, 0x93, 0x7c, 0x6a, 0x0e, 0xb4, 0x73, 0xc2, 0x44, 0xee, 0x85, 0xe4, 0x4a, 0x16, 0xe5, 0x92, 0x12, 0x96, 0x26, 0x66, 0x3a, 0xe8, 0x00, 0xce, 0xf1, 0x37, 0x10, 0xb4, 0x5c, 0x9e, 0x12, 0x11, 0x81, 0x47, 0x90, 0x6e, 0xb8, 0xd1, 0xf4, 0xfa, 0xc3, 0x41, 0x55, 0x8a, 0x5b, 0x67, 0xa1, 0x9a, 0x8a, 0xe4, 0xa9, 0xaf, 0x26},
+	},
+	{ /* 3P */
+		x:  fp.Elt{0x8f, 0x2f, 0xff, 0x86, 0x32, 0x17, 0x28, 0x57, 0x57, 0xa8, 0x5d, 0x46, 0x69, 0xb7, 0x62, 0xe8, 0xd6, 0x1f, 0x27, 0xf6, 0xf7, 0xcb, 0xa9, 0xda, 0xe8, 0xcf, 0x3f, 0x4a, 0x7a, 0x07, 0xba, 0xe2, 0xc7, 0x82, 0xda, 0x8c, 0x8b, 0x1b, 0x24, 0x32, 0x33, 0x94, 0xb6, 0x6c, 0x31, 0x64, 0xbd, 0x55, 0x64, 0xaf, 0x08, 0x91, 0x6b, 0x88, 0x65, 0x08},
+		y:  fp.Elt{0xfc, 0xd6, 0x8e, 0x58, 0x13, 0xac, 0x22, 0xb8, 0xaf, 0x2d, 0xd0, 0xfe, 0x68, 0x9a, 0xfa, 0xbf, 0xf0, 0x67, 0x67, 0xdb, 0x1b, 0x33, 0x3a, 0xbb, 0x58, 0x1d, 0x4e, 0xec, 0x82, 0x3c, 0xe4, 0xfc, 0xb9, 0xc3, 0x56, 0x23, 0x95, 0x8d, 0x4a, 0x9a, 0x44, 0xa6, 0x3a, 0xd4, 0x7a, 0xda, 0xcb, 0x06, 0xf7, 0x5c, 0x12, 0xd5, 0xdb, 0xa8, 0x05, 0xe0},
+		dt: fp.Elt{0x3a, 0x7a, 0x26, 0x6e, 0x41, 0x3a, 0xcd, 0x16, 0xd8, 0xc7, 0xe5, 0x10, 0x46, 0x88, 0x29, 0x11, 0x03, 0xf4, 0x42, 0x3e, 0x7e, 0x40, 0x92, 0x64, 0x05, 0xd3, 0x31, 0x0d, 0xb4, 0xc2, 0x79, 0x83, 0x19, 0xf4, 0xac, 0x53, 0xc3, 0x9e, 0x30, 0xda, 0x1b, 0xc4, 0xc2, 0xdf, 0xe8, 0x60, 0x7a, 0x03, 0x10, 0xbb, 0x9b, 0x60, 0x97, 0xd9, 0x44, 0x4f},
+	},
+	{ /* 5P */
+		x:  fp.Elt{0x34, 0x00, 0x03, 0x92, 0x10, 0x9d, 0xa9, 0xd0, 0x50, 0xf9, 0xc6, 0xef, 0x8c, 0x2d, 0x7b, 0xf0, 0x96, 0x3c, 0x0c, 0x92, 0x7a, 0xd5, 0xc0, 0x8b, 0x80, 0x12, 0x88, 0x95, 0xe8, 0x61, 0xd7, 0x56, 0xa7, 0xad, 0x62, 0x85, 0x72, 0xcf, 0xcb, 0x80, 0xef, 0x0d, 0xb5, 0xed, 0x1e, 0x60, 0xa7, 0x2b, 0x0e, 0xcb, 0x8d, 0xa4, 0x35, 0x93, 0x9f, 0x7a},
+		y:  fp.Elt{0xeb, 0x35, 0xf4, 0x72, 0x14, 0x73, 0xb4, 0x43, 0x54, 0x22, 0x1f, 0x88, 0x12, 0x55, 0x40, 0x58, 0x3c, 0xb3, 0xd2, 0x59, 0xee, 0xa4, 0xd7, 0x27, 0x71, 0x01, 0x98, 0xb6, 0xf7, 0x51, 0x65, 0xd8, 0xce, 0x8f, 0xb1, 0x3a, 0x82, 0xa1, 0x0c, 0x26, 0xde, 0x0a, 0x58, 0xfd, 0xe4, 0x9c, 0x10, 0xb9, 0xd3, 0xed, 0x17, 0x25, 0x1a, 0x75, 0xfd, 0xad},
+		dt: fp.Elt{0x05, 0xb3, 0x5b, 0x1f, 0x8e, 0x3e, 0x6c, 0xe0, 0xb2, 0x36, 0x2b, 0xf0, 0x34, 0x70, 0x05, 0xc7, 0x23, 0x38, 0x13, 0x67, 0x2d, 0x33, 0x4b, 0xf8, 0x90, 0xe7, 0xe3, 0x3a, 0x51, 0xa4, 0x2c, 0x54, 0x1f, 0x49, 0xe6, 0xdb, 0xa9, 0xa8, 0x66, 0x1a, 0x25, 0x4b, 0x8e, 0xce, 0x0d, 0xf8, 0x0c, 0xae, 0x7a, 0x32, 0x3b, 0xc1, 0x8f, 0x26, 0xfe, 0x67},
+	},
+	{ /* 7P */
+		x:  fp.Elt{0xf7, 0xea, 0xb5, 0xce, 0x67, 0x95, 0xdf, 0xd7, 0xc7, 0x8a, 0x47, 0x6b, 0x0a, 0x11, 0x0b, 0x6e, 0x70, 0x14, 0x50, 0x33, 0x2d, 0x09, 0xa2, 0xb5, 0xb0, 0xc7, 0xf9, 0x0d, 0x84, 0xe6, 0x68, 0xd5, 0x23, 0x42, 0xba, 0x9b, 0x71, 0xc3, 0xd8, 0xf2, 0x8a, 0xd7, 0xb6, 0x91, 0x52, 0x9a, 0x7b, 0x46, 0x77, 0xef, 0x9b, 0xc8, 0xe5, 0x48, 0x97, 0x07},
+		y:  fp.Elt{0x7f, 0x37, 0xac, 0xad, 0x3f, 0x0d, 0xe2, 0x09, 0x5c, 0x2b, 0x97, 0x66, 0xe8, 0x34, 0xb7, 0xbb, 0x40, 0x3c, 0x7a, 0x68, 0xd8, 0xc9, 0x84, 0x2f, 0xfd, 0x46, 0x39, 0x7b, 0x0e, 0xf5, 0x78, 0xca, 0x40, 0x0e, 0xd0, 0x79, 0x71, 0x7e, 0x41, 0x94, 0x75, 0xb8, 0x83, 0x35, 0xb2, 0xbc, 0x73, 0x73, 0x9c, 0x69, 0xfd, 0x90, 0x3c, 0xda, 0xde, 0x7d},
+		dt: fp.Elt{0xd0, 0xf0, 0xed, 0x38, 0x44, 0xe6, 0xfa, 0x84, 0x80, 0x9f, 0xa8, 0x6a, 0x18, 0x25, 0xe8, 0x4d, 0xa5, 0x07, 0xc9, 0xbb, 0xb4, 0xe2, 0x0e, 0xf6, 0x06, 0xf1, 0xe5, 0xe1, 0xd5, 0xfd, 0x38, 0x23, 0xce, 0xe6, 0xda, 0x88, 0x65, 0xcf, 0x4b, 0x5f, 0x0f, 0x34, 0x8a, 0x41, 0x15, 0x28, 0xbe, 0x6a, 0x79, 0xca, 0xf3, 0x56, 0xae, 0xe3, 0x8b, 0x6b},
+	},
+	{ /* 9P */
+		x:  fp.Elt{0x6b, 0x68, 0xab, 0x76, 0xef, 0x0d, 0x3d, 0x79, 0x7c, 0x9f, 0xc4, 0x7e, 0x46, 0x1a, 0xed, 0x89, 0x89, 0xfc, 0xf4, 0x53, 0x3e, 0xd9, 0xa0, 0x30, 0x44, 0x34, 0x1e, 0x10, 0xee, 0x44, 0xad, 0x18, 0x73, 0xae, 0xa3, 0x34, 0xd1, 0xe1, 0xda, 0x6c, 0xfa, 0xae, 0x4d, 0xad, 0x24, 0xd8, 0xd7, 0x8c, 0xaa, 0xfc, 0x84, 0xd5, 0xce, 0x50, 0x16, 0xef},
+		y:  fp.Elt{0x4f, 0x75, 0xf4, 0x74, 0xf6, 0x4d, 0xa7, 0x8b, 0xfb, 0xf3, 0x8e, 0xea, 0x2c, 0xf5, 0x40, 0x11, 0x97, 0x42, 0x2d, 0xc3, 0x47, 0xbb, 0x6f, 0x25, 0xda, 0x15, 0x1c, 0x39, 0x71, 0x56, 0x60, 0xba, 0xfa, 0x65, 0xc1, 0xb9, 0x93, 0x79, 0x68, 0x8c, 0x51, 0xf2, 0x4d, 0xa8, 0xd5, 0xcb, 0x7a, 0xaf, 0x2d, 0xae, 0x12, 0x8f, 0x29, 0xb6, 0x60, 0x15},
+		dt: fp.Elt{0x65, 0x61, 0xb3, 0x01, 0x47, 0xf1, 0xb1, 0x60, 0xdd, 0x37, 0x66, 0x7d, 0x1c, 0x22, 0x70, 0x67, 0x3d, 0x0d, 0x8a, 0x22, 0x41, 0x30, 0x5d, 0xc0, 0xb2, 0xa4, 0xaf, 0xe7, 0x0e, 0xda, 0xc3, 0x09, 0xac, 0x3d, 0x7a, 0xa7, 0xf1, 0xdf, 0xc8, 0x4e, 0x17, 0x38, 0xfe, 0xf1, 0x03, 0x6c, 0x12, 0xbc, 0xfe, 0x8f, 0x32, 0xab, 0xa8, 0xaa, 0xe7, 0x25},
+	},
+	{ /* 11P */
+		x:  fp.Elt{0x0a, 0xc1, 0x4d, 0x25, 0xa0, 0x4d, 0xef, 0xb8, 0x0d, 0x94, 0x55, 0x86, 0x11, 0x63, 0x48, 0x29, 0x2f, 0x98, 0x14, 0x0b, 0xe2, 0xba, 0x1d, 0x58, 0x75, 0x37, 0xb9, 0x67, 0x29, 0x50, 0x4f, 0x10, 0xe7, 0x2e, 0x42, 0x34, 0x2d, 0x12, 0xb5, 0x0d, 0x44, 0x5d, 0x40, 0xc6, 0xa4, 0x71, 0x6d, 0xe5, 0xb1, 0xee, 0x08, 0x24, 0xbc, 0xab, 0x12, 0xbf},
+		y:  fp.Elt{0x01, 0xaf, 0x16, 0x60, 0xf8, 0xc9, 0x0c, 0xab, 0x8c, 0x3d, 0xbf, 0x6a, 0x36, 0x88, 0x12, 0xfe, 0x2e, 0x3a, 0xa1, 0xdd, 0x85, 0x74, 0x06, 0xd0, 0x05, 0xf6, 0x0d, 0x39, 0xf7, 0x87, 0xd1, 0x06, 0x58, 0x8f, 0xf1, 0x20, 0x5d, 0x0c, 0xff, 0x00, 0xc9, 0x28, 0x33, 0x17, 0xe0, 0x23, 0x81, 0x30, 0xad, 0xfd, 0xf2, 0x4b, 0x55, 0x5b, 0xd3, 0x42},
+		dt: fp.Elt{0x84, 0x6d, 0xf1, 0x89, 0x90, 0x0b, 0x3d, 0x1b, 0x63, 0xd6, 0xd5, 0xfc, 0xa8, 0x86, 0x6d, 0x56, 0x3e, 0xf4, 0x95, 0xd0, 0x66, 0x07, 0xe3, 0xa5, 0x55, 0x74, 0x22, 0x6a, 0x6d, 0xc0, 0xd4, 0xe8, 0x2d, 0xe5, 0x5b, 0x9c, 0x3f, 0x45, 0x12, 0x01, 0xba, 0x7f, 0xd9, 0x26, 0x07, 0x0c, 0x8d, 0x4b, 0xd3, 0xfc, 0x30, 0xe1, 0x49, 0x30, 0x23, 0xbf},
+	},
+	{ /* 13P */
+		x:  fp.Elt{0xf1, 0xb1, 0xff, 0xf2, 0x35, 0x91, 0x00, 0x05, 0xc6, 0xf9, 0xe8, 0xc7, 0x9f, 0x09, 0x5a, 0xfa, 0x6b, 0x62, 0xda, 0x67, 0xcc, 0x2b, 0x55, 0x44, 0x23, 0xd1, 0x86, 0xc1, 0xe1, 0x39, 0xb3, 0x01, 0x25, 0x23, 0xb5, 0xc5, 0x08, 0x97, 0xfc, 0x44, 0xa5, 0x70, 0xe7, 0x28, 0xe9, 0xc1, 0xae, 0xba, 0x06, 0x0f, 0xf5, 0xf0, 0x2e, 0xdd, 0xae, 0x0b},
+		y:  fp.Elt{0xe5, 0x79, 0xf4, 0x8b, 0x6d, 0x5d, 0x53, 0xe9, 0xc3, 0x4e, 0x6e, 0x53, 0x6e, 0x15, 0xe2, 0x9b, 0xdb, 0x1d, 0x74, 0x65, 0x31, 0x36, 0xd7, 0x9f, 0x15, 0xf7, 0x8a, 0x98, 0xdd, 0x3d, 0xe3, 0x82, 0xa7, 0xd8, 0x13, 0x02, 0x90, 0xe6, 0x14, 0x42, 0x60, 0x54, 0x68, 0xa2, 0x04, 0x08, 0x6e, 0xd5, 0x34, 0x4c, 0x2a, 0xe5, 0xf0, 0x84, 0x9b, 0xc5},
+		dt: fp.Elt{0x9f, 0xfa, 0x73, 0x05, 0x55, 0x89, 0x25, 0x3c, 0x2d, 0x4e, 0x2a, 0xf3, 0xa8, 0x0c, 0x9f, 0x42, 0xd1, 0xde, 0x8c, 0x66, 0x94, 0xb4, 0x85, 0x12, 0x88, 0x4a, 0xda, 0x64, 0x30, 0x38, 0x2c, 0xff, 0x79, 0x2f, 0x47, 0x39, 0x14, 0x4a, 0x72, 0xaf, 0xc7, 0x9e, 0x0d, 0xe2, 0xfd, 0x1c, 0x22, 0x3e, 0xab, 0xf3, 0x3f, 0xa4, 0x06, 0xa3, 0x5a, 0x5a},
+	},
+	{ /* 15P */
+		x:  fp.Elt{0xd9, 0x29, 0x47, 0xf2, 0x45, 0x5d, 0x52, 0x27, 0x23, 0x71, 0xa8, 0xab, 0x68, 0x57, 0xdb, 0x35, 0x30, 0xb4, 0x43, 0x5e, 0xa2, 0x21, 0xef, 0x27, 0x89, 0xee, 0xa1, 0x15, 0x12, 0x61, 0x05, 0x16, 0xd2, 0x85, 0xa7, 0xf9, 0x8a, 0x50, 0xfd, 0xfb, 0xe2, 0x45, 0x69, 0xa9, 0x7b, 0xa3, 0x21, 0xf7, 0xb6, 0xd3, 0xd8, 0x16, 0xc2, 0xd8, 0xd6, 0x30},
+		y:  fp.Elt{0xc3, 0x74, 0x20, 0x85, 0xe0, 0x65, 0x30, 0x4e, 0x68, 0xa0, 0xa2, 0xb4, 0x40, 0xfa, 0x55, 0xf9, 0x63, 0xa7, 0x25, 0x13, 0x85, 0x00, 0x59, 0xf2, 0xc9, 0x19, 0xef, 0xd4, 0x56, 0x57, 0x66, 0x9f, 0x86, 0x9c, 0x79, 0x90, 0x29, 0x31, 0x23, 0x22, 0x05, 0x7b, 0x02, 0xb8, 0x8d, 0xb2, 0xc2, 0x86, 0xc9, 0x0a, 0xde, 0x8a, 0xf2, 0xb8, 0x8f, 0xf4},
+		dt: fp.Elt{0x8e, 0xe8, 0xf5, 0xdc, 0x04, 0x3c, 0x5f, 0xea, 0xb0, 0x4e, 0x6e, 0x89, 0x4d, 0x91, 0x1e, 0xef, 0xc9, 0x7a, 0xe1, 0x53, 0x6a, 0xa0, 0x9d, 0xf4, 0xc2, 0x49, 0x98, 0xfc, 0xc6, 0x64, 0xb5, 0xb5, 0x74, 0xfc, 0xea, 0xf3, 0x83, 0xf1, 0x05, 0x27, 0x42, 0x47, 0xfb, 0x76, 0x2a, 0x9c, 0x61, 0xc3, 0x15, 0xbb, 0x50, 0x87, 0x26, 0xa3, 0xff, 0x30},
+	},
+	{ /* 17P */
+		x:  fp.Elt{0x68, 0x9b, 0x64, 0x31, 0x17, 0x46, 0x1e, 0xdc, 0xb9, 0xbe, 0x65, 0x5d, 0x6e, 0xa9, 0x5d, 0x93, 0x81, 0xf4, 0xdf, 0x5d, 0x76, 0x2a, 0xbf, 0xf3, 0xc9, 0x32, 0xf1, 0x6c, 0x58, 0x56, 0xc3, 0x97, 0x5c, 0x6c, 0x9f, 0x60, 0x6e, 0x69, 0x94, 0x13, 0xcd, 0x99, 0xe4, 0xd5, 0xc0, 0x49, 0x92, 0xfa, 0x99, 0x95, 0x5a, 0x84, 0x38, 0x06, 0xcd, 0x1a},
+		y:  fp.Elt{0x87, 0x60, 0x63, 0x13, 0x54, 0x06, 0x0b, 0x7f, 0x7b, 0xa1, 0x8e, 0xe7, 0x20, 0xea, 0x67, 0x19, 0x16, 0xf6, 0xc5, 0xaf, 0x20, 0x28, 0x10, 0xc8, 0x2d, 0x8a, 0x6c, 0xfd, 0xfd, 0xc8, 0x32, 0x7e, 0x35, 0xf1, 0x4e, 0x88, 0x4a, 0x0e, 0x40, 0x00, 0xa4, 0x8a, 0x2f, 0xb8, 0x8c, 0xf4, 0xae, 0xfc, 0xd6, 0xfa, 0xd4, 0x3c, 0xdb, 0xc6, 0xa7, 0x7b},
+		dt: fp.Elt{0x67, 0xab, 0xf7, 0x44, 0x26, 0xe3, 0x22, 0x2a, 0x89, 0xe7, 0xa6, 0x36, 0x62, 0xd4, 0x18, 0xd3, 0x55, 0x80, 0xa1, 0x17, 0x23, 0xa4, 0xf9, 0xb6, 0xde, 0x77, 0x7e, 0x2c, 0xd4, 0x5a, 0x2f, 0x36, 0x03, 0x05, 0x67, 0x75, 0x01, 0x98, 0x81, 0xe6, 0x84, 0xb0, 0xbc, 0x27, 0x82, 0x51, 0x1f, 0x31, 0x60, 0x61, 0xf3, 0x64, 0x7e, 0x48, 0x01, 0x83},
+	},
+	{ /* 19P */
+		x:  fp.Elt{0xab, 0xc7, 0x19, 0x3d, 0x47, 0x43, 0xf8, 0x4d, 0x5c, 0x65, 0xdc, 0x76, 0x8e, 0x96, 0x2f, 0x9c, 0x4b, 0x9c, 0x7d, 0xc8, 0x52, 0x82, 0xa0, 0x4a, 0xae, 0x41, 0xf6, 0x65, 0x03, 0x36, 0x3c, 0x73, 0x39, 0x1a, 0x49, 0x98, 0x00, 0x81, 0xe5, 0xff, 0x06, 0xa6, 0xd4, 0x8a, 0xbf, 0x68, 0x0e, 0x92, 0x09, 0x82, 0x78, 0xdb, 0x86, 0x0c, 0x1a, 0x69},
+		y:  fp.Elt{0xf5, 0x3e, 0x4d, 0x3a, 0x88, 0x05, 0x52, 0xfe, 0x2e, 0xcf, 0x7a, 0x9b, 0x83, 0xee, 0x66, 0xca, 0x00, 0xac, 0xe2, 0x78, 0x4b, 0x91, 0xcb, 0x9f, 0x1f, 0x07, 0x3f, 0xbe, 0x3a, 0x94, 0xf6, 0x9b, 0x6c, 0xe6, 0x61, 0x9d, 0xb7, 0x61, 0x70, 0xe5, 0xb4, 0xe9, 0xbd, 0x06, 0x6c, 0xb5, 0x01, 0x1b, 0x8d, 0xe5, 0x6a, 0xf7, 0x5d, 0x31, 0xfa, 0x0d},
+		dt: fp.Elt{0xa2, 0x26, 0x41, 0xd7, 0x54, 0x98, 0x85, 0x76, 0x91, 0x5b, 0x6e, 0xac, 0xfa, 0x6f, 0x6c, 0xbf, 0x65, 0x8d, 0x6c, 0xd2, 0xcf, 0xf3, 0x0b, 0x23, 0x69, 0x05, 0xa6, 0xbd, 0xdd, 0x65, 0x35, 0x50, 0x5f, 0xb1, 0x1b, 0x57, 0xda, 0x12, 0x64, 0xb8, 0xd9, 0xd3, 0x5a, 0x8b, 0x76, 0xd1, 0xfc, 0xd8, 0xc9, 0x66, 0x28, 0x8e, 0x97, 0x6b, 0x8a, 0x2b},
+	},
+	{ /* 21P */
+		x:  fp.Elt{0x93, 0xd0, 0x1f, 0x5f, 0xf6, 0x3d, 0x80, 0x7e, 0xb7, 0x89, 0x34, 0x52, 0xd6, 0x1c, 0x95, 0xe2, 0x20, 0x5c, 0xe1, 0xd2, 0x2c, 0xd1, 0x12, 0xb9, 0xe9, 0x0b, 0x49, 0xcd, 0xd2, 0x86, 0xe8, 0xf2, 0x2f, 0x9a, 0xdd, 0x2a, 0xd7, 0xe9, 0xdf, 0x36, 0xc8, 0xa3, 0xc1, 0xe0, 0x98, 0x22, 0x5f, 0xad, 0xfc, 0x67, 0xf0, 0xbc, 0x24, 0x6e, 0x12, 0xed},
+		y:  fp.Elt{0xbc, 0x81, 0xdc, 0x33, 0x95, 0x33, 0x1e, 0x08, 0x6a, 0xce, 0x6e, 0xd7, 0xa4, 0xbe, 0x52, 0xb2, 0x91, 0x39, 0xde, 0x15, 0x1d, 0x97, 0xaf, 0x6d, 0xfe, 0x5c, 0xcc, 0x74, 0x93, 0x64, 0x82, 0xf0, 0x43, 0xd3, 0x5a, 0x49, 0x40, 0x06, 0x71, 0xa4, 0x38, 0x2d, 0x4d, 0xfa, 0xfc, 0x9f, 0x7b, 0xf4, 0xf7, 0x6c, 0x06, 0x18, 0x04, 0x49, 0x14, 0xef},
+		dt: fp.Elt{0xd6, 0x04, 0x96, 0x8e, 0xc4, 0x4a, 0x22, 0xb1, 0xd1, 0xef, 0x6f, 0xd5, 0x9c, 0xfa, 0x1f, 0x11, 0xcc, 0x5a, 0x8f, 0x06, 0x05, 0x87, 0x58, 0x0f, 0xf8, 0xd0, 0xcf, 0x1f, 0x1a, 0xf8, 0xe8, 0x72, 0x3f, 0x25, 0x96, 0x3a, 0xd6, 0x2b, 0x09, 0xe6, 0x78, 0xc0, 0x3c, 0xd7, 0xfb, 0x6a, 0xde, 0x0f, 0x9a, 0x51, 0x8a, 0x21, 0x17, 0xd2, 0x87, 0x32},
+	},
+	{ /* 23P */
+		x:  fp.Elt{0xab, 0x55, 0xbb, 0xb9, 0x86, 0x7f, 0x4e, 0xa3, 0x96, 0xf4, 0x53, 0x78, 0x0d, 0x31, 0x2c, 0xc4, 0xde, 0xc0, 0x2f, 0x68, 0xbd, 0x2a, 0xd3, 0x11, 0xa4, 0x47, 0xe0, 0xbd, 0xa2, 0x5e, 0x5a, 0x4c, 0x9b, 0x63, 0xea, 0xa1, 0x8f, 0xa0, 0x8b, 0x07, 0x52, 0x50, 0xf2, 0x29, 0x77, 0x30, 0xb2, 0x68, 0xc9, 0x28, 0x3e, 0x3d, 0x62, 0x5a, 0x7b, 0x56},
+		y:  fp.Elt{0xf7, 0xfb, 0x77, 0x59, 0x82, 0x1e, 0x17, 0xaa, 0x90, 0xe9, 0x0b, 0xc7, 0x19, 0x03, 0x69, 0xcd, 0x12, 0x3e, 0x02, 0x65, 0x8f, 0xe6, 0x15, 0x50, 0x9f, 0xb1, 0xb9, 0x1f, 0x7c, 0x8a, 0x56, 0x03, 0xf6, 0x83, 0x00, 0xac, 0xc5, 0xf3, 0xb1, 0x30, 0x3d, 0xba, 0x88, 0xa9, 0xd7, 0xd3, 0x09, 0xb5, 0xe7, 0xb6, 0xf6, 0xd0, 0x9c, 0xb9, 0x18, 0x23},
+		dt: fp.Elt{0x26, 0x59, 0x77, 0x64, 0x88, 0x45, 0xbc, 0xd1, 0x17, 0x3c, 0x37, 0xaa, 0xd5, 0xb8, 0x60, 0x66, 0x65, 0xa3, 0x59, 0x28, 0x64, 0xf9, 0x09, 0x0e, 0x6f, 0xe1, 0x39, 0x2e, 0x69, 0xac, 0x9f, 0xb6, 0x00, 0xe0, 0x8b, 0xc7, 0xa5, 0x0d, 0xea, 0xdc, 0x3b, 0x0d, 0xc0, 0x6a, 0x1b, 0xf2, 0x68, 0xc4, 0xce, 0xb8, 0xe3, 0x5f, 0xe3, 0x3a, 0x32, 0xb2},
+	},
+	{ /* 25P */
+		x:  fp.Elt{0xcf, 0xb2, 0x3a, 0x79, 0xb8, 0xd3, 0x54, 0x06, 0x83, 0x2d, 0xad, 0xbe, 0x6a, 0x36, 0x77, 0x49, 0x7a, 0x6d, 0xeb, 0xe8, 0x66, 0x2e, 0x07, 0xe0, 0xca, 0x88, 0x18, 0xa6, 0x15, 0x33, 0xbc, 0x5d, 0xef, 0xee, 0x9e, 0xf5, 0xe7, 0x63, 0xb1, 0x9d, 0xf0, 0x93, 0x9a, 0xde, 0x9a, 0x95, 0x95, 0x90, 0xee, 0xe0, 0x9b, 0xe5, 0x8c, 0x57, 0x7f, 0xaf},
+		y:  fp.Elt{0x9e, 0xe5, 0xec, 0xd5, 0xd8, 0xbf, 0x24, 0x23, 0x95, 0x68, 0xb3, 0x98, 0xa6, 0x8a, 0xcf, 0x92, 0xde, 0xe2, 0x5d, 0xa6, 0xa9, 0x00, 0xd3, 0x6a, 0xca, 0xdb, 0x11, 0xec, 0xca, 0x88, 0x9f, 0xa0, 0x3f, 0x7f, 0x21, 0xf7, 0x6a, 0x4d, 0x3b, 0x3e, 0xc3, 0xf6, 0x2d, 0x6d, 0xd8, 0x21, 0xfa, 0x3b, 0xcd, 0x25, 0x3f, 0xf1, 0x35, 0xf8, 0x97, 0x14},
+		dt: fp.Elt{0x75, 0xa1, 0x82, 0x5d, 0x5b, 0x6d, 0x4d, 0x17, 0xb0, 0x03, 0x38, 0x93, 0xdf, 0xa3, 0xbc, 0x77, 0xb6, 0x28, 0xa0, 0x7e, 0x6a, 0x39, 0x10, 0x8f, 0x55, 0x73, 0x5b, 0xb8, 0x3e, 0x93, 0x53, 0x86, 0xb6, 0x18, 0x50, 0x3f, 0x53, 0x43, 0xfd, 0x8b, 0x3d, 0x51, 0x7c, 0xf5, 0x92, 0x5f, 0x24, 0xef, 0xe7, 0x68, 0xc7, 0x89, 0x00, 0x8d, 0xa8, 0x42},
+	},
+	{ /* 27P */
+		x:  fp.Elt{0x1d, 0x3d, 0xd0, 0x8c, 0x56, 0x79, 0xa5, 0xf6, 0x8a, 0x15, 0xae, 0xcd, 0x17, 0xd7, 0x9f, 0xa1, 0x89, 0x73, 0xa9, 0xed, 0x59, 0x9c, 0xe9, 0x99, 0x00, 0x37, 0x2c, 0xb3, 0x91, 0xb5, 0xab, 0x1d, 0xa9, 0xdb, 0xa2, 0x97, 0x2c, 0x7c, 0x57, 0x7d, 0x69, 0x6d, 0x6d, 0xfa, 0x43, 0x2d, 0xfc, 0x23, 0x54, 0xbf, 0x82, 0xb3, 0x50, 0x8a, 0xea, 0x56},
+		y:  fp.Elt{0x1a, 0xc1, 0x80, 0xad, 0x7b, 0x98, 0x4a, 0xa5, 0x90, 0xd5, 0x17, 0xe2, 0xcd, 0xe4, 0x59, 0xe5, 0x97, 0x0f, 0x86, 0xd8, 0x3d, 0x3c, 0x59, 0x3b, 0x54, 0xe2, 0x45, 0xff, 0xb5, 0x3c, 0x34, 0x35, 0x45, 0xeb, 0x00, 0xdd, 0xfb, 0xbb, 0x97, 0xb9, 0xb9, 0x06, 0x24, 0xea, 0x6a, 0x71, 0x6b, 0xa3, 0x4d, 0x4e, 0x62, 0x7a, 0x75, 0x51, 0x76, 0x24},
+		dt: fp.Elt{0x08, 0x8b, 0xe0, 0x82, 0xbe, 0x41, 0x60, 0x81, 0x41, 0x27, 0x5b, 0x96, 0x15, 0xe6, 0x1a, 0x8f, 0xc7, 0x46, 0xc4, 0x30, 0x90, 0x35, 0xdb, 0x66, 0x51, 0xae, 0xe3, 0x67, 0x22, 0x79, 0xab, 0x32, 0x17, 0x4f, 0xac, 0x00, 0x1b, 0x7f, 0xf3, 0x00, 0x8b, 0x63, 0x1d, 0x41, 0x91, 0x93, 0x3f, 0x74, 0x7d, 0x6f, 0x9b, 0x15, 0x15, 0x1a, 0x30, 0x32},
+	},
+	{ /* 29P */
+		x:  fp.Elt{0x0d, 0x4e, 0x47, 0x03, 0x7d, 0x20, 0x32, 0x73, 0xcc, 0x41, 0x4b, 0xf0, 0xfb, 0x3f, 0x39, 0xeb, 0x19, 0x53, 0xc4, 0x4d, 0x5c, 0x63, 0xb4, 0x58, 0xe7, 0x9b, 0xe2, 0xfe, 0xa7, 0xc7, 0x30, 0x1c, 0x38, 0x8a, 0xcc, 0x53, 0x0e, 0xfe, 0x49, 0x4e, 0x7f, 0x14, 0xde, 0xa2, 0x35, 0x6e, 0xe2, 0xb2, 0x05, 0x66, 0x36, 0x2f, 0x29, 0xf0, 0x62, 0x43},
+		y:  fp.Elt{0x85, 0x4b, 0x47, 0xc8, 0xd0, 0x76, 0x04, 0x08, 0xf1, 0xca, 0xfc, 0x65, 0x8c, 0x9d, 0x6a, 0x4b, 0xd5, 0x41, 0x40, 0x8d, 0xf5, 0xb0, 0xe4, 0x38, 0x2f, 0x86, 0xe6, 0x3e, 0x54, 0x4f, 0xb4, 0x53, 0x9d, 0x7c, 0x7c, 0xb5, 0x0d, 0xfb, 0x80, 0xfd, 0xa3, 0x36, 0xc8, 0x8a, 0x8a, 0xa1, 0x4b, 0xd9, 0xfc, 0x05, 0x5c, 0xf3, 0x68, 0xd5, 0xb1, 0xc1},
+		dt: fp.Elt{0x65, 0x94, 0x3c, 0x1b, 0xa4, 0x2f, 0xff, 0x94, 0x0c, 0x89, 0x5e, 0x72, 0x99, 0xba, 0x2c, 0xe6, 0x0e, 0xed, 0x3c, 0xc8, 0xcf, 0x70, 0x76, 0xd6, 0x89, 0xd2, 0x7c, 0x1b, 0xe6, 0x60, 0x6d, 0x9f, 0x76, 0xed, 0xff, 0x11, 0xce, 0xf6, 0x0f, 0x23, 0x28, 0xb6, 0x28, 0x92, 0xae, 0x87, 0x2d, 0xd1, 0xbe, 0xfc, 0x4b, 0xa6, 0xc3, 0xf5, 0x38, 0x6e},
+	},
+	{ /* 31P */
+		x:  fp.Elt{0x0d, 0xd3, 0xbd, 0xc1, 0x9f, 0x53, 0x16, 0xfb, 0x4a, 0xdf, 0x38, 0xe5, 0x56, 0x13, 0xdb, 0xae, 0xa1, 0x85, 0x5d, 0x54, 0xc0, 0x6b, 0x39, 0x89, 0xa4, 0x37, 0x20, 0xeb, 0x94, 0x08, 0x66, 0xd5, 0xcb, 0x7f, 0x89, 0x4a, 0x10, 0x7d, 0x9b, 0x8a, 0xa5, 0x02, 0x80, 0xb9, 0x96, 0xcc, 0x24, 0xfa, 0x57, 0x46, 0x89, 0xbd, 0x35, 0x8e, 0x44, 0xf6},
+		y:  fp.Elt{0x83, 0x5c, 0x80, 0x18, 0x74, 0x72, 0xee, 0xc6, 0xcf, 0x92, 0x49, 0x27, 0xfa, 0x10, 0x21, 0x6b, 0xe6, 0x39, 0x19, 0x14, 0x95, 0x09, 0x80, 0xd0, 0x4b, 0xa4, 0xff, 0xe0, 0x2b, 0xa2, 0x0d, 0x22, 0x32, 0x43, 0x17, 0x31, 0x68, 0x9e, 0x35, 0xff, 0x91, 0x48, 0x7b, 0x68, 0x7d, 0x3a, 0xd7, 0x7e, 0x40, 0xcf, 0x99, 0x1d, 0x75, 0xe0, 0xb4, 0x2f},
+		dt: fp.Elt{0x43, 0x39, 0x1b, 0x92, 0x31, 0x52, 0x2c, 0x06, 0x71, 0xe2, 0xe5, 0xd5, 0x24, 0xd3, 0x19, 0x01, 0x37, 0x9c, 0x38, 0x75, 0x86, 0xa6, 0x25, 0x37, 0x61, 0xc4, 0x2d, 0xcb, 0xd3, 0x73, 0xed, 0xae, 0xff, 0xc7, 0xf8, 0xa3, 0xa6, 0xa0, 0xb1, 0x66, 0xf5, 0x75, 0x1e, 0xff, 0xe0, 0xe5, 0xef, 0xf7, 0x4d, 0x38, 0x60, 0x6d, 0x71, 0xf2, 0xb7, 0x21},
+	},
+	{ /* 33P */
+		x:  fp.Elt{0x5c, 0xdd, 0xfd, 0x19, 0x9a, 0xfe, 0x28, 0x85, 0xe5, 0x06, 0x78, 0x5a, 0x46, 0x97, 0x32, 0x2b, 0x1f, 0x3b, 0x62, 0x64, 0x76, 0xb8, 0xaf, 0x95, 0x25, 0x04, 0x39, 0xd0, 0x22, 0x3e, 0x1b, 0x21, 0x46, 0xb0, 0x2b, 0xa7, 0xd2, 0x08, 0xc4, 0xc2, 0x85, 0x9e, 0x3a, 0xf4, 0x7d, 0x51, 0x49, 0x4e, 0x34, 0x96, 0xf7, 0x7f, 0xcc, 0xbe, 0x43, 0xaf},
+		y:  fp.Elt{0x11, 0xbc, 0x4e, 0xcd, 0x8b, 0x0a, 0xdc, 0x46, 0xd1, 0x6e, 0xf1, 0xaa, 0xc7, 0x4f, 0x9b, 0x5f, 0xf0, 0x3f, 0xb1, 0xa0, 0xe6, 0xdb, 0xd6, 0xf3, 0x42, 0x61, 0x57, 0x43, 0x62, 0xb5, 0xcb, 0xde, 0x64, 0x1a, 0x98, 0xd8, 0xb1, 0xf4, 0x40, 0x9d, 0xf2, 0xee, 0xa8, 0xaf, 0x38, 0xb6, 0xde, 0x70, 0xdb, 0xa4, 0xcf, 0x32, 0x29, 0x16, 0x4e, 0xd2},
+		dt: fp.Elt{0xcb, 0xa2, 0x67, 0x20, 0x2b, 0x47, 0x0e, 0xa7, 0xc3, 0xb1, 0x01, 0xc0, 0x76, 0x5c, 0x3f, 0x76, 0xb1, 0x19, 0xf1, 0x1d, 0x89, 0x26, 0x18, 0x7b, 0x62, 0x2e, 0x2b, 0x0a, 0xa1, 0x72, 0xbe, 0x60, 0xea, 0xcf, 0x5b, 0x72, 0x85, 0x45, 0x3c, 0x07, 0x57, 0x14, 0x59, 0xa6, 0x26, 0x82, 0x8b, 0xb0, 0x21, 0x6a, 0xde, 0x68, 0x6f, 0x37, 0xcf, 0x0e},
+	},
+	{ /* 35P */
+		x:  fp.Elt{0xbc, 0x4d, 0x59, 0x93, 0x28, 0x04, 0x0b, 0x8d, 0x74, 0xb2, 0xeb, 0x26, 0x7c, 0xe7, 0xf6, 0xd3, 0xaa, 0x2e, 0xad, 0xdf, 0x21, 0x65, 0xcf, 0x9c, 0x0f, 0xd3, 0xd0, 0xcf, 0xe1, 0x87, 0x0a, 0x3e, 0x80, 0x88, 0xf7, 0x6e, 0xd3, 0xa1, 0xc2, 0x79, 0x11, 0x77, 0xde, 0x6d, 0xdf, 0x21, 0x00, 0x54, 0xb2, 0xd9, 0x69, 0xe1, 0x1f, 0x10, 0xb1, 0x38},
+		y:  fp.Elt{0xd5, 0xe6, 0x20, 0x10, 0x03, 0x35, 0x3e, 0x4c, 0x03, 0xad, 0xe3, 0xa3, 0x9d, 0xbe, 0xfa, 0x76, 0x20, 0x13, 0x38, 0xb8, 0xc1, 0xfd, 0x28, 0xe5, 0x68, 0xe3, 0xa7, 0xfa, 0x43, 0x89, 0xd0, 0x3e, 0x4e, 0xa2, 0xb0, 0xf3, 0x8c, 0x92, 0x6f, 0x80, 0x0e, 0x53, 0x16, 0x22, 0x53, 0x4c, 0xd9, 0x2a, 0x82, 0x7d, 0x6f, 0xa1, 0x42, 0x25, 0xec, 0xce},
+		dt: fp.Elt{0x0c, 0x7c, 0x70, 0xbc, 0xd3, 0x68, 0x2d, 0x0e, 0x4d, 0x58, 0xc7, 0x60, 0x9d, 0xee, 0xaf, 0x11, 0x8c, 0x87, 0x70, 0xc9, 0x6d, 0x7b, 0x5d, 0x04, 0x0f, 0xbf, 0xb5, 0xb3, 0x61, 0x1f, 0xfe, 0x7e, 0x2c, 0xc3, 0x21, 0x80, 0xd0, 0xd1, 0x78, 0xee, 0x1e, 0x68, 0xac, 0xa0, 0xe6, 0xfa, 0x0f, 0x12, 0xf8, 0x5c, 0x12, 0xd9, 0x91, 0x18, 0xbb, 0x89},
+	},
+	{ /* 37P */
+		x:  fp.Elt{0xe3, 0xe0, 0x2f, 0xb2, 0x0c, 0xf9, 0xa5, 0x76, 0xcc, 0xcb, 0x47, 0x5d, 0x74, 0xd7, 0xa0, 0xd3, 0x3c, 0x9e, 0x36, 0x2c, 0x63, 0x64, 0x49, 0x0e, 0x9b, 0xd1, 0xa3, 0x62, 0xf0, 0xed, 0x8c, 0x87, 0x6a, 0xe1, 0x2b, 0x37, 0x9e, 0xe8, 0x28, 0xc4, 0x9f, 0xfb, 0x70, 0xa7, 0x5b, 0x6f, 0x38, 0xa4, 0x3e, 0x2f, 0x89, 0x7d, 0x07, 0xfa, 0x29, 0x8e},
+		y:  fp.Elt{0x3f, 0x6a, 0x79, 0x81, 0x70, 0xf3, 0x73, 0x11, 0x5b, 0x26, 0x73, 0x1f, 0x89, 0xbc, 0x29, 0x20, 0x06, 0x09, 0x9c, 0xf9, 0x95, 0x99, 0xc0, 0xb5, 0xc2, 0x8a, 0x0f, 0x2f, 0x3c, 0x7d, 0xf0, 0xdb, 0x62, 0xe6, 0xd2, 0xc2, 0x00, 0xed, 0xcc, 0x0d, 0xfe, 0xb2, 0xd1, 0xac, 0x29, 0x73, 0x87, 0xc9, 0x61, 0x1e, 0x75, 0x68, 0x18, 0x5a, 0x74, 0x25},
+		dt: fp.Elt{0xde, 0x5e, 0x20, 0xf1, 0xba, 0xe6, 0x14, 0xa0, 0xc5, 0x17, 0xeb, 0x41, 0x18, 0x5f, 0x1e, 0xed, 0x3f, 0xba, 0x0a, 0x7f, 0x48, 0x86, 0x5b, 0x85, 0x2f, 0x72, 0x6f, 0x6f, 0x3b, 0x92, 0xea, 0x2f, 0x8b, 0x59, 0x31, 0xc6, 0x87, 0x8f, 0xc6, 0x83, 0x23, 0xdb, 0x09, 0xce, 0x7e, 0xb8, 0xa4, 0x19, 0x7f, 0xf8, 0x2b, 0x71, 0xa9, 0xe1, 0xa4, 0xe4},
+	},
+	{ /* 39P */
+		x:  fp.Elt{0x15, 0x57, 0x6d, 0x0e, 0xf5, 0xd3, 0xdb, 0xeb, 0x3b, 0xd6, 0xef, 0x8c, 0xf2, 0x06, 0xb6, 0xaa, 0xea, 0xaf, 0x8c, 0xc1, 0x26, 0x68, 0x39, 0x22, 0x89, 0x5d, 0x09, 0x25, 0x71, 0xdb, 0xb3, 0xfc, 0x3a, 0x66, 0xb0, 0x72, 0xb6, 0x49, 0xbd, 0x77, 0x47, 0x58, 0xfb, 0xfb, 0x7b, 0xca, 0x32, 0x8f, 0xdf, 0xf7, 0xa6, 0x08, 0x76, 0x82, 0x5d, 0x34},
+		y:  fp.Elt{0x6f, 0x2d, 0xaa, 0x1e, 0x55, 0x8f, 0xbc, 0x19, 0x75, 0xf9, 0xf9, 0x33, 0x77, 0xd8, 0x4c, 0xaa, 0xd5, 0x72, 0x6e, 0x79, 0x49, 0xd4, 0xae, 0x56, 0x8b, 0x08, 0x71, 0xca, 0x88, 0xbc, 0x8f, 0xc5, 0x59, 0x25, 0xdb, 0x9b, 0xc3, 0x88, 0x9d, 0x80, 0xc2, 0x15, 0x25, 0xc4, 0x55, 0x86, 0x23, 0x82, 0x7d, 0x59, 0xea, 0xef, 0xd0, 0xdd, 0xa7, 0x03},
+		dt: fp.Elt{0xb0, 0x30, 0x7f, 0x04, 0x54, 0xf2, 0x7e, 0x94, 0x9a, 0xd0, 0xe3, 0xdf, 0xd2, 0x43, 0x3e, 0xc9, 0x72, 0xbb, 0xf2, 0x51, 0xd4, 0x97, 0x71, 0x76, 0xd4, 0xc6, 0x60, 0xe9, 0xa2, 0x70, 0x93, 0x42, 0x9e, 0x2b, 0x8d, 0xe1, 0xd8, 0x8a, 0xdb, 0x98, 0x6a, 0x56, 0x14, 0x68, 0x9b, 0xa9, 0xae, 0x13, 0xef, 0x6a, 0x8c, 0x6d, 0x81, 0x59, 0x25, 0xc8},
+	},
+	{ /* 41P */
+		x:  fp.Elt{0xdc, 0xb2, 0x34, 0x85, 0x7d, 0xb5, 0xb4, 0x0f, 0x10, 0x4a, 0xda, 0x5c, 0xed, 0xe1, 0x9c, 0x33, 0xe5, 0xb3, 0x3b, 0x11, 0x31, 0xbf, 0xca, 0x25, 0x73, 0xf5, 0x4c, 0x16, 0x61, 0xdd, 0x90, 0x3d, 0xb3, 0x03, 0xcb, 0xd9, 0xc1, 0x33, 0x84, 0x6e, 0x8d, 0x41, 0x71, 0x21, 0x5d, 0x31, 0x17, 0xea, 0x87, 0x9d, 0x19, 0x7b, 0x92, 0x39, 0x86, 0xf5},
+		y:  fp.Elt{0x5f, 0xe7, 0x2f, 0x47, 0xf6, 0x3d, 0x98, 0x92, 0xc7, 0x43, 0x31, 0x3b, 0x4d, 0xd7, 0x94, 0x11, 0xac, 0x15, 0xff, 0x15, 0x05, 0xab, 0x98, 0xe9, 0x6b, 0xd3, 0x1f, 0xc8, 0x3a, 0x52, 0x74, 0x02, 0xe3, 0x4c, 0x39, 0xb5, 0x78, 0xe8, 0x6e, 0xb5, 0x2d, 0x6f, 0xa0, 0x9b, 0x07, 0x8c, 0xc7, 0x95, 0x70, 0x8b, 0x3a, 0x88, 0x3d, 0xb6, 0xd9, 0xf4},
+		dt: fp.Elt{0xfe, 0x1a, 0x51, 0x35, 0x04, 0x39, 0x08, 0x0e, 0xe4, 0x0e, 0x9d, 0x9a, 0xb0, 0xe8, 0x06, 0x06, 0x96, 0x89, 0x22, 0x12, 0xad, 0x04, 0x2c, 0xae, 0x73, 0x34, 0xaa, 0xfd, 0xc9, 0x46, 0x89, 0xde, 0x35, 0x60, 0x96, 0xf8, 0x2e, 0x9b, 0xe8, 0xca, 0xa6, 0x4c, 0x94, 0xfe, 0x85, 0xb2, 0xec, 0x27, 0x08, 0xbf, 0x63, 0xfe, 0x69, 0x87, 0x32, 0x1e},
+	},
+	{ /* 43P */
+		x:  fp.Elt{0x81, 0xb3, 0x68, 0xec, 0xce, 0xe1, 0x2c, 0x06, 0x6a, 0xed, 0xd7, 0x4d, 0xbc, 0x2b, 0x1b, 0x8e, 0x09, 0xf5, 0xa4, 0x1f, 0xcb, 0xbe, 0x9d, 0xb4, 0x96, 0xa0, 0xef, 0xe3, 0xe2, 0x35, 0x16, 0x32, 0x08, 0x6f, 0xb2, 0x24, 0x2b, 0x48, 0xf5, 0xa7, 0x4a, 0x87, 0xdf, 0x8a, 0x3d, 0xc4, 0xb7, 0x09, 0x8e, 0x31, 0xbe, 0x35, 0xe5, 0xc9, 0xce, 0x82},
+		y:  fp.Elt{0x27, 0xef, 0x62, 0x57, 0x7c, 0x86, 0x89, 0x9f, 0x8d, 0xda, 0x41, 0x83, 0x30, 0x45, 0x57, 0x50, 0x6b, 0x25, 0xa3, 0x34, 0xc1, 0xe7, 0xfe, 0xbb, 0x79, 0xcc, 0xff, 0xe7, 0xed, 0x7a, 0xb8, 0x3f, 0x53, 0xad, 0x9e, 0x0a, 0x67, 0xa8, 0x10, 0xc8, 0x9b, 0x60, 0x92, 0x2f, 0x8c, 0x57, 0xc9, 0x47, 0x6d, 0x2f, 0x3b, 0xa2, 0xc3, 0x06, 0x01, 0x61},
+		dt: fp.Elt{0xd1, 0xaa, 0xf5, 0x33, 0xe8, 0xaf, 0x06, 0x09, 0x87, 0xd6, 0xbb, 0x87, 0xca, 0xf5, 0x26, 0x3f, 0xa2, 0xdd, 0x66, 0x0b, 0x45, 0x36, 0x66, 0x8e, 0xb7, 0x9e, 0xc8, 0x8d, 0x6e, 0x7e, 0xa3, 0x12, 0x7d, 0x88, 0xf2, 0xb7, 0x01, 0xac, 0xb4, 0x96, 0x09, 0xd3, 0x0d, 0xd0, 0x95, 0x93, 0x87, 0x67, 0x27, 0x54, 0x15, 0xc8, 0x2b, 0x33, 0x60, 0x21},
+	},
+	{ /* 45P */
+		x:  fp.Elt{0x51, 0x9b, 0x8f, 0x5a, 0x91, 0xb2, 0x0f, 0xb6, 0xc1, 0xbb, 0x09, 0x64, 0x24, 0x57, 0xb9, 0x1a, 0x9b, 0x87, 0x92, 0x86, 0x24, 0x83, 0xb7, 0x0b, 0xe1, 0xe7, 0xe0, 0xb6, 0xf5, 0x0c, 0xc0, 0xcf, 0x8d, 0xd0, 0x33, 0x90, 0xdf, 0x61, 0xd6, 0x16, 0x62, 0xf8, 0xdd, 0x7c, 0x86, 0x89, 0xa4, 0xac, 0x42, 0x07, 0x13, 0x99, 0x9d, 0x66, 0x93, 0x44},
+		y:  fp.Elt{0xe5, 0x83, 0x38, 0x1d, 0xee, 0x0c, 0x29, 0x17, 0xba, 0x81, 0x90, 0xfd, 0x10, 0x0d, 0x77, 0x97, 0x60, 0x45, 0x30, 0x87, 0x74, 0x16, 0x77, 0x61, 0xc4, 0x08, 0x6e, 0xd8, 0xee, 0xb9, 0xf0, 0x6e, 0xfe, 0x51, 0xa7, 0x24, 0x81, 0x77, 0xe8, 0x97, 0xf2, 0x39, 0x1c, 0xdc, 0x5e, 0x2a, 0xac, 0x35, 0xe3, 0x3c, 0x95, 0xf3, 0x0d, 0x34, 0x35, 0x38},
+		dt: fp.Elt{0xc5, 0xe6, 0xca, 0x1d, 0x5e, 0xe3, 0x41, 0x71, 0x0c, 0x5b, 0x05, 0xe2, 0x3d, 0x0e, 0x4a, 0x61, 0x70, 0x52, 0xdb, 0x2b, 0xb5, 0xff, 0x60, 0x7c, 0xb2, 0xe8, 0x1d, 0xa2, 0x09, 0x87, 0x2c, 0x19, 0xae, 0x06, 0xad, 0xdd, 0x41, 0x76, 0x0d, 0x68, 0x68, 0x24, 0x71, 0xe3, 0x27, 0x34, 0xad, 0xc4, 0x8a, 0x05, 0xbc, 0x24, 0xf1, 0x72, 0x9c, 0xec},
+	},
+	{ /* 47P */
+		x:  fp.Elt{0x76, 0xb4, 0x2f, 0x61, 0xe5, 0x23, 0x97, 0xd4, 0xe8, 0x53, 0x99, 0x33, 0x18, 0xa3, 0xaa, 0x81, 0x30, 0xba, 0xcf, 0xe1, 0x99, 0xea, 0x50, 0x9f, 0x55, 0x42, 0x3d, 0xd7, 0x62, 0x30, 0xa0, 0x5c, 0xdb, 0x33, 0x22, 0xa5, 0xd1, 0x8d, 0xcf, 0xa3, 0x22, 0xdd, 0xb5, 0x7a, 0x47, 0x97, 0x58, 0xb9, 0x9f, 0x39, 0x09, 0x73, 0xd5, 0xe4, 0x8e, 0x8d},
+		y:  fp.Elt{0xe7, 0x24, 0xe1, 0x78, 0xcc, 0xd8, 0x3b, 0x1c, 0xe9, 0x52, 0xbe, 0xb8, 0x54, 0xc3, 0xe7, 0x89, 0x19, 0xe2, 0xbd, 0x17, 0xe8, 0xae, 0x15, 0x34, 0x52, 0x87, 0xae, 0x45, 0xef, 0x58, 0xe8, 0x01, 0x6c, 0x79, 0x86, 0x77, 0xe4, 0x1d, 0xd9, 0x74, 0x2b, 0xb5, 0x3b, 0xca, 0x71, 0x4b, 0xb3, 0xfc, 0x36, 0xaf, 0xc5, 0x9a, 0x17, 0x9b, 0x91, 0xff},
+		dt: fp.Elt{0xd1, 0x8f, 0x36, 0x7a, 0xc2, 0x2f, 0xb6, 0x79, 0x69, 0xaf, 0xc4, 0xb8, 0x95, 0x0a, 0x63, 0x4b, 0xdf, 0x6b, 0xcd, 0x4d, 0x8f, 0x53, 0x13, 0xa5, 0xf6, 0x05, 0x13, 0x38, 0x55, 0xe7, 0xf1, 0x3b, 0xe0, 0xe1, 0x17, 0x04, 0x45, 0xb5, 0xe7, 0xef, 0xeb, 0x8d, 0x6c, 0x7f, 0xdd, 0xb7, 0x0b, 0x3a, 0x75, 0x47, 0x4b, 0x92, 0x6a, 0xc1, 0x34, 0x46},
+	},
+	{ /* 49P */
+		x:  fp.Elt{0x3f, 0xf2, 0x89, 0xc5, 0x77, 0x87, 0x98, 0x60, 0x5f, 0x8e, 0xb3, 0xeb, 0x03, 0x7e, 0xc3, 0x1b, 0xef, 0x43, 0x53, 0x39, 0xa8, 0x51, 0xb1, 0xa5, 0x20, 0x7a, 0x92, 0x05, 0xe1, 0x8b, 0x6a, 0x50, 0xc1, 0x74, 0x66, 0x1f, 0x62, 0xff, 0x30, 0xf8, 0xa0, 0x22, 0x5c, 0xce, 0x17, 0x80, 0xc9, 0xc8, 0xdc, 0xb8, 0xf8, 0xe1, 0x3b, 0xd4, 0x67, 0x7e},
+		y:  fp.Elt{0x22, 0x50, 0xa7, 0xe6, 0x25, 0xf2, 0x47, 0xa5, 0x39, 0xd1, 0xcd, 0xbf, 0x24, 0x0e, 0xcf, 0x8a, 0x46, 0x49, 0xcc, 0x40, 0x52, 0x52, 0x5c, 0x50, 0x12, 0x11, 0x0e, 0x15, 0x3f, 0x1a, 0xf0, 0xd1, 0x3c, 0x0a, 0x04, 0x67, 0x9f, 0xc5, 0x31, 0x93, 0xf9, 0x88, 0xdc, 0x28, 0x2c, 0x23, 0xf6, 0xa1, 0x38, 0xe7, 0x21, 0xbd, 0xd9, 0x51, 0xd0, 0xdd},
+		dt: fp.Elt{0xb2, 0x09, 0x46, 0x97, 0xe9, 0x9c, 0x80, 0x92, 0x00, 0x12, 0x4c, 0x0e, 0xe3, 0xbd, 0xde, 0xf3, 0x1a, 0x92, 0x92, 0x17, 0x5c, 0x07, 0xb7, 0x10, 0xd8, 0xea, 0xa1, 0xa4, 0xb1, 0xb2, 0x44, 0x96, 0xd2, 0xba, 0xe7, 0x05, 0x12, 0xa3, 0x10, 0xe0, 0xbf, 0x34, 0x44, 0xda, 0x4b, 0xc7, 0x2a, 0xd6, 0xd5, 0xa4, 0x12, 0x19, 0xa0, 0x4e, 0x43, 0xc6},
+	},
+	{ /* 51P */
+		x:  fp.Elt{0x9b, 0x35, 0xad, 0x29, 0xcf, 0x50, 0x94, 0x51, 0x0d, 0x9b, 0xaa, 0x90, 0xdb, 0x2d, 0x7e, 0xe1, 0x1a, 0x20, 0x51, 0x27, 0xf0, 0x2d, 0xa8, 0x00, 0xeb, 0xdf, 0x93, 0x71, 0xb3, 0x3a, 0xcc, 0x0a, 0xbc, 0xe7, 0x17, 0xfb, 0xec, 0xe5, 0x18, 0x47, 0x5d, 0xee, 0xd1, 0x70, 0x82, 0x5b, 0x51, 0xc3, 0x27, 0x4e, 0xd1, 0x9b, 0xe9, 0x3a, 0xe6, 0x0d},
+		y:  fp.Elt{0x44, 0x9e, 0x24, 0x28, 0x8f, 0x59, 0x4a, 0xd7, 0x23, 0xf2, 0xda, 0xeb, 0xd7, 0x49, 0x6f, 0x29, 0x9d, 0x06, 0x03, 0xb1, 0x2a, 0x1d, 0x3c, 0xad, 0x07, 0xe0, 0x20, 0x83, 0xf2, 0xe3, 0x16, 0x95, 0x54, 0x83, 0x71, 0x38, 0xea, 0x61, 0x3a, 0x5c, 0xe5, 0x16, 0x57, 0xc2, 0xd9, 0xaf, 0x1d, 0x3e, 0xc5, 0xa2, 0x55, 0xc5, 0xbc, 0x24, 0xf7, 0xb2},
+		dt: fp.Elt{0x61, 0x22, 0x6f, 0xba, 0x0b, 0x2f, 0x15, 0x33, 0xac, 0xee, 0xff, 0x14, 0x39, 0xe9, 0xa7, 0x29, 0xd2, 0xf0, 0xf2, 0x66, 0xe3, 0xd2, 0x2e, 0xdc, 0x14, 0x6e, 0xd2, 0xaa, 0x9f, 0x48, 0x49, 0x7d, 0x9e, 0x30, 0x44, 0xd0, 0xbf, 0xcf, 0x76, 0x39, 0x5c, 0x33, 0x5a, 0xce, 0xf6, 0x8f, 0x14, 0x7e, 0xf6, 0x5b, 0x5b, 0xc0, 0x45, 0x59, 0x4c, 0xcc},
+	},
+	{ /* 53P */
+		x:  fp.Elt{0xfe, 0xca, 0x19, 0xc1, 0xe4, 0x71, 0x0c, 0xe2, 0xc7, 0x67, 0x09, 0x4c, 0x12, 0xe3, 0xf6, 0x64, 0x7a, 0xad, 0x4e, 0x3c, 0x3c, 0x92, 0x0a, 0xeb, 0xdb, 0xea, 0x62, 0xa7, 0x40, 0x03, 0xea, 0x4d, 0xb0, 0xa7, 0x53, 0xec, 0x26, 0x36, 0x05, 0x73, 0x0c, 0x61, 0x99, 0x75, 0x3a, 0x26, 0x20, 0x10, 0x56, 0x8d, 0x33, 0x21, 0x6f, 0x4a, 0x7f, 0x34},
+		y:  fp.Elt{0x41, 0xfe, 0x05, 0x8e, 0x25, 0xde, 0x0c, 0xa8, 0x3f, 0x57, 0xe4, 0xcf, 0x9f, 0xf3, 0xde, 0x37, 0x1d, 0xb2, 0xe1, 0x99, 0x8d, 0xc7, 0xbd, 0xfa, 0x47, 0x47, 0x32, 0xa5, 0xfe, 0xf5, 0x10, 0xe5, 0xbe, 0x86, 0x7a, 0x04, 0xe2, 0x39, 0xd6, 0xa8, 0x69, 0x7c, 0x4f, 0x9d, 0xb5, 0x75, 0xfa, 0x46, 0xfc, 0xde, 0x38, 0x8b, 0x20, 0xca, 0x7e, 0x2d},
+		dt: fp.Elt{0x00, 0xe3, 0x03, 0x4e, 0xd7, 0xf1, 0x2c, 0xfe, 0x50, 0xbf, 0xc5, 0xcb, 0x5b, 0xab, 0xf0, 0x5e, 0xd7, 0xc6, 0x86, 0x5e, 0xda, 0x98, 0x90, 0x55, 0xbe, 0x3c, 0xe6, 0xa8, 0x51, 0xfb, 0x91, 0x4b, 0x8e, 0x77, 0x39, 0xe2, 0x29, 0x77, 0x2f, 0x80, 0xf5, 0xf0, 0x8c, 0x19, 0xb6, 0xf0, 0xdb, 0x65, 0xd0, 0xdc, 0xc3, 0x0d, 0x97, 0xd2, 0x5e, 0x6f},
+	},
+	{ /* 55P */
+		x:  fp.Elt{0x7a, 0x80, 0x78, 0x97, 0x3f, 0x9d, 0xf0, 0xe8, 0x55, 0xdd, 0xc7, 0xa9, 0x6f, 0x3c, 0x5e, 0xe6, 0x71, 0x46, 0x59, 0xf6, 0xfa, 0x2e, 0x03, 0xfd, 0x46, 0xbd, 0x62, 0x54, 0x7c, 0xef, 0x2b, 0x21, 0xd8, 0xa7, 0x2b, 0xcc, 0x4a, 0xf1, 0x05, 0x73, 0x9b, 0xc5, 0x12, 0x18, 0x6d, 0x10, 0x14, 0x72, 0x58, 0x0e, 0xa7, 0x1b, 0xbd, 0x96, 0xba, 0xcc},
+		y:  fp.Elt{0xea, 0x44, 0xed, 0x2a, 0x54, 0x61, 0xa7, 0x3c, 0xf7, 0xbe, 0x24, 0x3b, 0x46, 0x39, 0x2e, 0x73, 0xa5, 0x5e, 0x26, 0x91, 0x99, 0x30, 0x9f, 0xb1, 0x6d, 0x94, 0x25, 0x53, 0x2d, 0x0a, 0xdd, 0x68, 0x61, 0xa3, 0xb8, 0x95, 0x59, 0x0c, 0xdb, 0x19, 0xf5, 0x91, 0x65, 0x0e, 0x82, 0x99, 0x6e, 0xdb, 0x53, 0x21, 0xe6, 0xad, 0x45, 0x82, 0x6c, 0x2d},
+		dt: fp.Elt{0xad, 0x13, 0xca, 0x26, 0xe1, 0x96, 0x12, 0xa0, 0xf1, 0x4d, 0x21, 0xd8, 0x3b, 0x0e, 0x5c, 0x17, 0x63, 0xe8, 0x12, 0xbb, 0x4b, 0xfe, 0x90, 0xe9, 0xe7, 0x89, 0x60, 0x00, 0x77, 0x4b, 0x9e, 0x7e, 0xd2, 0xc0, 0x63, 0x69, 0x9c, 0x78, 0x87, 0x3d, 0xe2, 0x83, 0xbf, 0x91, 0x69, 0x69, 0xa6, 0x36, 0x32, 0xd4, 0xd0, 0xf9, 0x4f, 0x09, 0x00, 0x8d},
+	},
+	{ /* 57P */
+		x:  fp.Elt{0xac, 0x28, 0xf2, 0x57, 0xed, 0xb9, 0x4e, 0x77, 0x0d, 0x33, 0x16, 0x5a, 0x71, 0x1b, 0x51, 0xba, 0x2d, 0x51, 0x6d, 0x5c, 0xe6, 0xd9, 0x13, 0x25, 0xca, 0x75, 0x29, 0xed, 0x56, 0xae, 0x19, 0xaf, 0xd5, 0xba, 0xaa, 0xeb, 0x15, 0x6d, 0x08, 0x22, 0x99, 0xdb, 0x89, 0xd6, 0x6b, 0x9e, 0x0e, 0xe6, 0xbb, 0x6d, 0x5e, 0xc1, 0x27, 0xdc, 0xc9, 0x32},
+		y:  fp.Elt{0xac, 0x74, 0x5f, 0xa1, 0x01, 0xd8, 0xd9, 0x53, 0x7d, 0x20, 0x4a, 0x91, 0x5c, 0x5f, 0x4f, 0x24, 0x61, 0x8a, 0xae, 0x9f, 0xd5, 0x1d, 0x51, 0x21, 0xff, 0x55, 0xc7, 0x3a, 0xd6, 0x46, 0x33, 0xba, 0x17, 0x57, 0x8c, 0x0a, 0x59, 0xb5, 0x41, 0x39, 0x2d, 0xf3, 0x46, 0x5c, 0x8b, 0x52, 0x36, 0xb8, 0x28, 0xe3, 0x4b, 0x1b, 0x12, 0xf5, 0x6f, 0xa4},
+		dt: fp.Elt{0x73, 0xfe, 0x02, 0x90, 0x8e, 0x76, 0xa0, 0xa3, 0xd4, 0x7e, 0x6d, 0x5c, 0x6d, 0xeb, 0xb2, 0x63, 0x53, 0xfe, 0x99, 0x85, 0x2b, 0x8b, 0x1a, 0x47, 0xa3, 0xa3, 0x99, 0x52, 0x19, 0x02, 0x4b, 0x7a, 0x2a, 0xda, 0x3d, 0xe8, 0x55, 0x32, 0x7a, 0x9e, 0xf6, 0xc2, 0x49, 0xc7, 0xdc, 0xcc, 0x7c, 0x2b, 0xe0, 0x48, 0x6e, 0xbd, 0x2b, 0x34, 0x3d, 0x60},
+	},
+	{ /* 59P */
+		x:  fp.Elt{0x44, 0x63, 0x1a, 0x29, 0xec, 0x03, 0xd5, 0x05, 0x54, 0xdc, 0x0a, 0x80, 0x7a, 0x1d, 0xdf, 0xd5, 0x6f, 0x29, 0x71, 0x6a, 0xe7, 0xd9, 0x9e, 0xba, 0xfe, 0xf3, 0x25, 0x41, 0x6a, 0x23, 0x4b, 0xad, 0x1e, 0x5b, 0x44, 0xfc, 0x57, 0x58, 0x26, 0x82, 0xdd, 0x52, 0x0f, 0xe3, 0x42, 0x0b, 0x4b, 0x5f, 0x88, 0x17, 0xdb, 0x8c, 0x93, 0x8b, 0x3d, 0x82},
+		y:  fp.Elt{0x92, 0xfc, 0x15, 0xcf, 0x31, 0x5b, 0x58, 0x75, 0xff, 0xe2, 0xe8, 0xc3, 0xc4, 0x58, 0x3f, 0x64, 0x2e, 0x95, 0xe1, 0x13, 0x95, 0x83, 0x3c, 0xa8, 0xbe, 0x10, 0xc8, 0xd1, 0xe1, 0xff, 0x1e, 0x50, 0xd9, 0x40, 0x04, 0x58, 0xa2, 0x84, 0x10, 0xcb, 0x0d, 0xcc, 0xf0, 0xb8, 0x2d, 0x1d, 0x17, 0xf1, 0x0a, 0x89, 0xe6, 0x72, 0x95, 0x6d, 0x77, 0xe4},
+		dt: fp.Elt{0x80, 0xcc, 0x21, 0xcc, 0x07, 0xc5, 0x7b, 0x74, 0x43, 0x66, 0x12, 0xca, 0xa5, 0x3b, 0xfa, 0x83, 0x6b, 0x24, 0x37, 0x76, 0x2e, 0x7f, 0xcf, 0x3f, 0xf3, 0xcd, 0xde, 0x26, 0x8a, 0x1a, 0xdb, 0x08, 0x20, 0xdf, 0x88, 0x93, 0xa9, 0x81, 0x32, 0x63, 0xff, 0x9f, 0x0d, 0x2a, 0x89, 0xab, 0xe9, 0xca, 0x01, 0xe7, 0xf0, 0x59, 0xbb, 0x92, 0x01, 0x0a},
+	},
+	{ /* 61P */
+		x:  fp.Elt{0x89, 0xf0, 0x81, 0xa0, 0x2f, 0x7c, 0x3d, 0x12, 0x05, 0x35, 0x8e, 0x75, 0xf9, 0x77, 0xe8, 0xb3, 0xf1, 0x42, 0x11, 0xf1, 0x66, 0x1a, 0xb6, 0x75, 0xeb, 0xf2, 0x22, 0xc5, 0x48, 0xf9, 0x4b, 0xa2, 0x24, 0x43, 0xba, 0x83, 0x44, 0x3b, 0x19, 0xdc, 0x7b, 0x1d, 0x30, 0x95, 0x7a, 0x69, 0xf0, 0xcd, 0xdd, 0x2d, 0xdb, 0x48, 0x85, 0xb0, 0xe5, 0x53},
+		y:  fp.Elt{0xc8, 0x20, 0x1e, 0xda, 0x59, 0xdb, 0xc4, 0xcd, 0xaa, 0x2b, 0xc9, 0xf1, 0xfb, 0xe5, 0x3b, 0x9c, 0xf4, 0xed, 0x65, 0x73, 0x08, 0xcd, 0xa2, 0x97, 0x3f, 0xfb, 0xab, 0x63, 0x94, 0xac, 0xfe, 0x8b, 0xa7, 0xfc, 0x53, 0xc7, 0xfe, 0x25, 0x73, 0xca, 0x22, 0x1e, 0x93, 0xa9, 0x3b, 0x89, 0xbc, 0x4e, 0xd4, 0x1e, 0x23, 0x36, 0x4f, 0xa2, 0x84, 0x91},
+		dt: fp.Elt{0xa6, 0x1f, 0xc1, 0xec, 0x9e, 0xdc, 0x05, 0x93, 0xb9, 0x7a, 0x10, 0x5d, 0x53, 0xf2, 0x18, 0x81, 0x25, 0xb9, 0x63, 0x46, 0x83, 0xbc, 0xaa, 0x07, 0x62, 0x8b, 0xe7, 0xb3, 0x8e, 0x5a, 0xcf, 0xd2, 0x01, 0x2b, 0x25, 0xef, 0x96, 0x66, 0xb6, 0x10, 0xb6, 0x74, 0x6c, 0x22, 0xac, 0xfd, 0xeb, 0x91, 0x08, 0x0b, 0xf1, 0x0a, 0xc7, 0xc9, 0x0f, 0x0c},
+	},
+	{ /* 63P */
+		x:  fp.Elt{0x85, 0xb5, 0x11, 0xfb, 0x0e, 0x98, 0xf0, 0x69, 0xda, 0xe3, 0xbb, 0x26, 0xe3, 0x4a, 0x47, 0x5a, 0x38, 0xc1, 0x13, 0xdf, 0xeb, 0x54, 0x5e, 0x80, 0xc1, 0xc0, 0x0f, 0x2f, 0xd8, 0x16, 0x13, 0x7a, 0x2a, 0xbb, 0xec, 0x5d, 0x0e, 0x83, 0x68, 0x92, 0x88, 0x78, 0xcf, 0x90, 0x41, 0x47, 0xc7, 0xc8, 0xc5, 0xd8, 0x63, 0x33, 0xcd, 0x6b, 0x2b, 0xed},
+		y:  fp.Elt{0xda, 0x38, 0x5e, 0x7a, 0x2a, 0x9b, 0x3d, 0x15, 0xb9, 0x52, 0xe9, 0xc8, 0xf4, 0x4f, 0x5e, 0x6b, 0x61, 0x45, 0x5c, 0xe1, 0x75, 0x8e, 0xcf, 0xc8, 0x7b, 0x15, 0xd8, 0x7f, 0x74, 0x43, 0x1d, 0x47, 0x0d, 0x56, 0x8e, 0xb7, 0x4d, 0x92, 0x77, 0xa7, 0xc2, 0x37, 0xbc, 0x28, 0xff, 0x6a, 0xab, 0x90, 0xd2, 0x5e, 0x88, 0x32, 0x30, 0xd0, 0x0b, 0x90},
+		dt: fp.Elt{0x18, 0xd5, 0xa4, 0xee, 0x73, 0x4a, 0x8c, 0xd7, 0xdc, 0xd7, 0xc2, 0x82, 0x57, 0x29, 0xe2, 0xff, 0x2f, 0x7f, 0x16, 0x6f, 0x0b, 0x22, 0x84, 0x30, 0x94, 0x66, 0xd5, 0xc5, 0xbf, 0xf0, 0xcf, 0xaf, 0xb9, 0x88, 0x7e, 0x08, 0x44, 0xaf, 0x6a, 0x5c, 0xdc, 0x0f, 0x46, 0xc5, 0x2d, 0xdf, 0xa5, 0x8c, 0xab, 0xaa, 0xa5, 0x4d, 0xcc, 0x93, 0x17, 0x03},
+	},
+}
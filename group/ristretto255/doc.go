@@ -0,0 +1,12 @@
+// Package ristretto255 provides the ristretto255 prime-order group.
+//
+// Ristretto255 is a group of prime order L, which is built from the
+// edwards25519 curve. Unlike the group of points of edwards25519, it has
+// no cofactor, so it is suitable for protocols that require a prime-order
+// group, such as OPRFs, PAKEs, and anonymous credentials. All the
+// operations of this package are constant-time.
+//
+// References:
+//   - RFC9496 https://rfc-editor.org/rfc/rfc9496.txt
+//   - Ristretto https://ristretto.group
+package ristretto255
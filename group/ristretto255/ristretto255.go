@@ -0,0 +1,263 @@
+package ristretto255
+
+import (
+	"crypto/subtle"
+
+	"github.com/cloudflare/circl/group/edwards25519"
+	fp "github.com/cloudflare/circl/math/fp25519"
+)
+
+// Size is the length in bytes of the encodings of elements and scalars.
+const Size = 32
+
+// UniformSize is the length in bytes of the input of FromUniformBytes.
+const UniformSize = 2 * Size
+
+// Scalar is an integer modulo the order of the group. Scalars are encoded
+// and decoded as 32-byte integers in little-endian order.
+type Scalar = edwards25519.Scalar
+
+// Element is an element of the ristretto255 group. The zero value is not a
+// valid element; use SetIdentity, SetGenerator, Unmarshal, or
+// FromUniformBytes to initialize it.
+type Element struct{ p edwards25519.Point }
+
+var (
+	// paramD is the parameter d = -121665/121666 of edwards25519.
+	paramD = fp.Elt{
+		0xa3, 0x78, 0x59, 0x13, 0xca, 0x4d, 0xeb, 0x75,
+		0xab, 0xd8, 0x41, 0x41, 0x4d, 0x0a, 0x70, 0x00,
+		0x98, 0xe8, 0x79, 0x77, 0x79, 0x40, 0xc7, 0x8c,
+		0x73, 0xfe, 0x6f, 0x2b, 0xee, 0x6c, 0x03, 0x52,
+	}
+	// sqrtM1 is sqrt(-1).
+	sqrtM1 = fp.Elt{
+		0xb0, 0xa0, 0x0e, 0x4a, 0x27, 0x1b, 0xee, 0xc4,
+		0x78, 0xe4, 0x2f, 0xad, 0x06, 0x18, 0x43, 0x2f,
+		0xa7, 0xd7, 0xfb, 0x3d, 0x99, 0x00, 0x4d, 0x2b,
+		0x0b, 0xdf, 0xc1, 0x4f, 0x80, 0x24, 0x83, 0x2b,
+	}
+	// sqrtADMinusOne is sqrt(a*d-1), where a = -1.
+	sqrtADMinusOne = fp.Elt{
+		0x1b, 0x2e, 0x7b, 0x49, 0xa0, 0xf6, 0x97, 0x7e,
+		0xbd, 0x54, 0x78, 0x1b, 0x0c, 0x8e, 0x9d, 0xaf,
+		0xfd, 0xd1, 0xf5, 0x31, 0xc9, 0xfc, 0x3c, 0x0f,
+		0xac, 0x48, 0x83, 0x2b, 0xbf, 0x31, 0x69, 0x37,
+	}
+	// invSqrtAMinusD is 1/sqrt(a-d), where a = -1.
+	invSqrtAMinusD = fp.Elt{
+		0xea, 0x40, 0x5d, 0x80, 0xaa, 0xfd, 0xc8, 0x99,
+		0xbe, 0x72, 0x41, 0x5a, 0x17, 0x16, 0x2f, 0x9d,
+		0x40, 0xd8, 0x01, 0xfe, 0x91, 0x7b, 0xc2, 0x16,
+		0xa2, 0xfc, 0xaf, 0xcf, 0x05, 0x89, 0x6c, 0x78,
+	}
+	// oneMinusDSq is 1-d^2.
+	oneMinusDSq = fp.Elt{
+		0x76, 0xc1, 0x5f, 0x94, 0xc1, 0x09, 0x7c, 0xe2,
+		0x0f, 0x35, 0x5e, 0xcd, 0x38, 0xa1, 0x81, 0x2c,
+		0xe4, 0xdf, 0x70, 0xbe, 0xdd, 0xab, 0x94, 0x99,
+		0xd7, 0xe0, 0xb3, 0xb2, 0xa8, 0x72, 0x90, 0x02,
+	}
+	// dMinusOneSq is (d-1)^2.
+	dMinusOneSq = fp.Elt{
+		0x20, 0x4d, 0xed, 0x44, 0xaa, 0x5a, 0xad, 0x31,
+		0x99, 0x19, 0x1e, 0xb0, 0x2c, 0x4a, 0x9e, 0xd2,
+		0xeb, 0x4e, 0x9b, 0x52, 0x2f, 0xd3, 0xdc, 0x4c,
+		0x41, 0x22, 0x6c, 0xf6, 0x7a, 0xb3, 0x68, 0x59,
+	}
+)
+
+// SetIdentity sets e to the identity element.
+func (e *Element) SetIdentity() { e.p.SetIdentity() }
+
+// SetGenerator sets e to the canonical generator of the group.
+func (e *Element) SetGenerator() { e.p.SetGenerator() }
+
+// IsIdentity returns true if e is the identity element.
+func (e *Element) IsIdentity() bool {
+	x, y, _, _ := e.p.ExtendedCoordinates()
+	return fp.IsZero(&x) || fp.IsZero(&y)
+}
+
+// IsEqual returns true if e and f represent the same element.
+func (e *Element) IsEqual(f *Element) bool {
+	x1, y1, _, _ := e.p.ExtendedCoordinates()
+	x2, y2, _, _ := f.p.ExtendedCoordinates()
+	l, r := &fp.Elt{}, &fp.Elt{}
+	// Checks that x1*y2 = y1*x2 or y1*y2 = x1*x2.
+	fp.Mul(l, &x1, &y2)
+	fp.Mul(r, &y1, &x2)
+	b0 := fp.IsEqual(l, r)
+	fp.Mul(l, &y1, &y2)
+	fp.Mul(r, &x1, &x2)
+	b1 := fp.IsEqual(l, r)
+	return (b0 | b1) == 1
+}
+
+// Add calculates e = f + g.
+func (e *Element) Add(f, g *Element) { e.p.Add(&f.p, &g.p) }
+
+// Sub calculates e = f - g.
+func (e *Element) Sub(f, g *Element) { e.p.Sub(&f.p, &g.p) }
+
+// Neg calculates e = -f.
+func (e *Element) Neg(f *Element) { e.p.Neg(&f.p) }
+
+// Double calculates e = 2f.
+func (e *Element) Double(f *Element) { e.p.Double(&f.p) }
+
+// ScalarMult calculates e = kf.
+func (e *Element) ScalarMult(k *Scalar, f *Element) { e.p.ScalarMult(k, &f.p) }
+
+// ScalarBaseMult calculates e = kG, where G is the generator of the group.
+func (e *Element) ScalarBaseMult(k *Scalar) { e.p.ScalarBaseMult(k) }
+
+// Marshal stores the canonical encoding of e in out.
+func (e *Element) Marshal(out *[Size]byte) {
+	x0, y0, z0, t0 := e.p.ExtendedCoordinates()
+	u1, u2, t := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+	one, invSqrt := &fp.Elt{}, &fp.Elt{}
+	den1, den2, zInv := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+
+	fp.Add(u1, &z0, &y0)
+	fp.Sub(t, &z0, &y0)
+	fp.Mul(u1, u1, t)    // u1 = (z0+y0)*(z0-y0)
+	fp.Mul(u2, &x0, &y0) // u2 = x0*y0
+	fp.Sqr(t, u2)        // t = u2^2
+	fp.Mul(t, t, u1)     // t = u1*u2^2
+	fp.SetOne(one)
+	fp.SqrtRatioM1(invSqrt, one, t)
+	fp.Mul(den1, invSqrt, u1) // den1 = invsqrt*u1
+	fp.Mul(den2, invSqrt, u2) // den2 = invsqrt*u2
+	fp.Mul(zInv, den1, den2)
+	fp.Mul(zInv, zInv, &t0) // zInv = den1*den2*t0
+
+	ix0, iy0, enDen := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+	fp.Mul(ix0, &x0, &sqrtM1)            // ix0 = x0*sqrt(-1)
+	fp.Mul(iy0, &y0, &sqrtM1)            // iy0 = y0*sqrt(-1)
+	fp.Mul(enDen, den1, &invSqrtAMinusD) // enDen = den1*invsqrt(a-d)
+	fp.Mul(t, &t0, zInv)
+	rotate := fp.IsNegative(t) // rotate = t0*zInv < 0
+	x, y, denInv := x0, y0, *den2
+	fp.Cmov(&x, iy0, rotate)        // x = rotate ? iy0 : x0
+	fp.Cmov(&y, ix0, rotate)        // y = rotate ? ix0 : y0
+	fp.Cmov(&denInv, enDen, rotate) // denInv = rotate ? enDen : den2
+	fp.Mul(t, &x, zInv)
+	cneg(&y, fp.IsNegative(t)) // y = x*zInv < 0 ? -y : y
+	fp.Sub(t, &z0, &y)
+	fp.Mul(t, t, &denInv)     // s = denInv*(z-y)
+	cneg(t, fp.IsNegative(t)) // s = |s|
+	fp.ToBytes(out[:], t)
+}
+
+// Unmarshal sets e to the element encoded in the input, and returns false
+// if it is not the canonical encoding of an element. In that case, e is
+// not modified.
+func (e *Element) Unmarshal(in *[Size]byte) bool {
+	s := &fp.Elt{}
+	copy(s[:], in[:])
+	fp.Modp(s)
+	// s must be canonically encoded and non-negative.
+	isCanonical := uint(subtle.ConstantTimeCompare(s[:], in[:])) & (1 - fp.IsNegative(s))
+
+	one, ss, u1, u2, u2Sqr, v := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+	fp.SetOne(one)
+	fp.Sqr(ss, s)       // ss = s^2
+	fp.Sub(u1, one, ss) // u1 = 1-s^2
+	fp.Add(u2, one, ss) // u2 = 1+s^2
+	fp.Sqr(u2Sqr, u2)   // u2Sqr = u2^2
+	fp.Sqr(v, u1)
+	fp.Mul(v, v, &paramD)
+	fp.Add(v, v, u2Sqr)
+	fp.Neg(v, v) // v = -(d*u1^2) - u2Sqr
+
+	invSqrt, t := &fp.Elt{}, &fp.Elt{}
+	fp.Mul(t, v, u2Sqr)
+	isQR := fp.SqrtRatioM1(invSqrt, one, t)
+
+	denX, denY := &fp.Elt{}, &fp.Elt{}
+	var x, y, z, xy fp.Elt
+	fp.Mul(denX, invSqrt, u2) // denX = invsqrt*u2
+	fp.Mul(denY, invSqrt, denX)
+	fp.Mul(denY, denY, v) // denY = invsqrt*denX*v
+	fp.Add(&x, s, s)
+	fp.Mul(&x, &x, denX)        // x = 2*s*denX
+	cneg(&x, fp.IsNegative(&x)) // x = |x|
+	fp.Mul(&y, u1, denY)        // y = u1*denY
+	fp.Mul(&xy, &x, &y)         // t = x*y
+	fp.SetOne(&z)
+
+	// Decoding fails if v*u2^2 is not a square, x*y is negative or y = 0.
+	ok := isCanonical & isQR & (1 - fp.IsNegative(&xy)) & (1 - fp.IsEqual(&y, &fp.Elt{}))
+	if ok == 0 {
+		return false
+	}
+	return e.p.SetExtendedCoordinates(&x, &y, &z, &xy)
+}
+
+// FromUniformBytes sets e to the element obtained by mapping 64 uniformly
+// random bytes to the group, such as the output of a hash function. The
+// resulting element has a distribution indistinguishable from uniform.
+func (e *Element) FromUniformBytes(in *[UniformSize]byte) {
+	var r0, r1 fp.Elt
+	copy(r0[:], in[:Size])
+	copy(r1[:], in[Size:])
+	r0[Size-1] &= 0x7f
+	r1[Size-1] &= 0x7f
+
+	var P, Q edwards25519.Point
+	elligator(&P, &r0)
+	elligator(&Q, &r1)
+	e.p.Add(&P, &Q)
+}
+
+// elligator maps a field element to a point, as specified by the MAP
+// function of RFC-9496.
+func elligator(P *edwards25519.Point, t *fp.Elt) {
+	one, r, u, v, c, s, sPrime := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+	fp.SetOne(one)
+	fp.Sqr(r, t)          // t^2
+	fp.Mul(r, r, &sqrtM1) // r = sqrt(-1)*t^2
+	fp.Add(u, r, one)
+	fp.Mul(u, u, &oneMinusDSq) // u = (r+1)*(1-d^2)
+	fp.Add(c, r, &paramD)      // r+d
+	fp.Mul(v, r, &paramD)
+	fp.Add(v, v, one)
+	fp.Neg(v, v)    // -1-r*d
+	fp.Mul(v, v, c) // v = (-1-r*d)*(r+d)
+	wasSquare := fp.SqrtRatioM1(s, u, v)
+	fp.Mul(sPrime, s, t)
+	cneg(sPrime, fp.IsNegative(sPrime))
+	fp.Neg(sPrime, sPrime)          // sPrime = -|s*t|
+	fp.Cmov(s, sPrime, 1-wasSquare) // s = wasSquare ? s : sPrime
+	fp.Neg(c, one)
+	fp.Cmov(c, r, 1-wasSquare) // c = wasSquare ? -1 : r
+
+	N, w0, w1, w2, w3 := &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}, &fp.Elt{}
+	fp.Sub(N, r, one)
+	fp.Mul(N, N, c)
+	fp.Mul(N, N, &dMinusOneSq)
+	fp.Sub(N, N, v) // N = c*(r-1)*(d-1)^2 - v
+	fp.Add(w0, s, s)
+	fp.Mul(w0, w0, v)              // w0 = 2*s*v
+	fp.Mul(w1, N, &sqrtADMinusOne) // w1 = N*sqrt(a*d-1)
+	fp.Sqr(w3, s)
+	fp.Sub(w2, one, w3) // w2 = 1-s^2
+	fp.Add(w3, one, w3) // w3 = 1+s^2
+
+	var x, y, z, xy fp.Elt
+	fp.Mul(&x, w0, w3)
+	fp.Mul(&y, w2, w1)
+	fp.Mul(&z, w1, w3)
+	fp.Mul(&xy, w0, w2)
+	if !P.SetExtendedCoordinates(&x, &y, &z, &xy) {
+		panic("ristretto255: invalid point")
+	}
+}
+
+// cneg sets x = -x if b is 1.
+func cneg(x *fp.Elt, b uint) {
+	var t fp.Elt
+	fp.Neg(&t, x)
+	fp.Cmov(x, &t, b)
+}
@@ -0,0 +1,70 @@
+package test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+)
+
+// DRBG is the AES-256 CTR_DRBG (without derivation function) as defined in
+// NIST SP 800-90A and used by the NIST PQC reference code (rng.c) to generate
+// known answer tests.
+type DRBG struct {
+	key [32]byte
+	v   [16]byte
+}
+
+// NewDRBG returns DRBG instantiated with 48 bytes of entropy, same as
+// randombytes_init() with no personalization string.
+func NewDRBG(entropy *[48]byte) *DRBG {
+	d := new(DRBG)
+	d.update(entropy[:])
+	return d
+}
+
+// Read fills p with pseudo-random bytes. A single call to Read corresponds to
+// a single call to randombytes(), hence the output depends on how the stream
+// is split into calls. Read never fails.
+func (d *DRBG) Read(p []byte) (int, error) {
+	var block [aes.BlockSize]byte
+	c := d.cipher()
+	for i := 0; i < len(p); i += aes.BlockSize {
+		d.incV()
+		c.Encrypt(block[:], d.v[:])
+		copy(p[i:], block[:])
+	}
+	d.update(nil)
+	return len(p), nil
+}
+
+func (d *DRBG) cipher() cipher.Block {
+	c, err := aes.NewCipher(d.key[:])
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// incV increments the counter V as a big-endian integer.
+func (d *DRBG) incV() {
+	for j := len(d.v) - 1; j >= 0; j-- {
+		d.v[j]++
+		if d.v[j] != 0 {
+			break
+		}
+	}
+}
+
+// update implements AES256_CTR_DRBG_Update, data is either nil or 48 bytes.
+func (d *DRBG) update(data []byte) {
+	var tmp [48]byte
+	c := d.cipher()
+	for i := 0; i < len(tmp); i += aes.BlockSize {
+		d.incV()
+		c.Encrypt(tmp[i:], d.v[:])
+	}
+	for i := range data {
+		tmp[i] ^= data[i]
+	}
+	copy(d.key[:], tmp[:32])
+	copy(d.v[:], tmp[32:])
+}
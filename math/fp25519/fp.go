@@ -1,7 +1,11 @@
 // Package fp25519 provides prime field arithmetic over GF(2^255-19).
 package fp25519
 
-import "github.com/cloudflare/circl/internal/conv"
+import (
+	"crypto/subtle"
+
+	"github.com/cloudflare/circl/internal/conv"
+)
 
 // Size in bytes of an element.
 const Size = 32
@@ -40,16 +44,77 @@ func SetOne(x *Elt) { *x = Elt{}; x[0] = 1 }
 // Neg calculates z = -x.
 func Neg(z, x *Elt) { Sub(z, &p, x) }
 
+// sqrtMinusOne is a square root of -1 modulo p.
+var sqrtMinusOne = Elt{
+	0xb0, 0xa0, 0x0e, 0x4a, 0x27, 0x1b, 0xee, 0xc4,
+	0x78, 0xe4, 0x2f, 0xad, 0x06, 0x18, 0x43, 0x2f,
+	0xa7, 0xd7, 0xfb, 0x3d, 0x99, 0x00, 0x4d, 0x2b,
+	0x0b, 0xdf, 0xc1, 0x4f, 0x80, 0x24, 0x83, 0x2b,
+}
+
 // InvSqrt calculates z = sqrt(x/y) iff x/y is a quadratic-residue, which is
 // indicated by returning isQR = true. Otherwise, when x/y is a quadratic
 // non-residue, z will have an undetermined value and isQR = false.
 func InvSqrt(z, x, y *Elt) (isQR bool) {
-	sqrtMinusOne := &Elt{
-		0xb0, 0xa0, 0x0e, 0x4a, 0x27, 0x1b, 0xee, 0xc4,
-		0x78, 0xe4, 0x2f, 0xad, 0x06, 0x18, 0x43, 0x2f,
-		0xa7, 0xd7, 0xfb, 0x3d, 0x99, 0x00, 0x4d, 0x2b,
-		0x0b, 0xdf, 0xc1, 0x4f, 0x80, 0x24, 0x83, 0x2b,
+	t0, t1, t2 := &Elt{}, &Elt{}, &Elt{}
+	sqrtCandidate(z, x, y)
+	// Checking whether y z^2 == x
+	Sqr(t0, z)     // t0 = z^2
+	Mul(t0, t0, y) // t0 = yz^2
+	Sub(t1, t0, x) // t1 = t0-u
+	Add(t2, t0, x) // t2 = t0+u
+	if IsZero(t1) {
+		return true
+	} else if IsZero(t2) {
+		Mul(z, z, &sqrtMinusOne) // z = z*sqrt(-1)
+		return true
+	} else {
+		return false
 	}
+}
+
+// SqrtRatioM1 calculates z = sqrt(x/y) in constant time, following the
+// SQRT_RATIO_M1 function of RFC-9496. If x/y is a quadratic-residue, z is
+// its non-negative square root and isQR = 1. Otherwise, z is the
+// non-negative square root of sqrt(-1)*x/y and isQR = 0. If y = 0, then
+// z = 0, and isQR = 1 only if x = 0. The flag can be passed to Cmov.
+func SqrtRatioM1(z, x, y *Elt) (isQR uint) {
+	t0, t1, xi := &Elt{}, &Elt{}, &Elt{}
+	u, v := *x, *y
+	sqrtCandidate(z, &u, &v)
+	Sqr(t0, z)      // t0 = z^2
+	Mul(t0, t0, &v) // t0 = yz^2
+	Neg(t1, &u)     // t1 = -x
+	Mul(xi, t1, &sqrtMinusOne)
+	correctSign := IsEqual(t0, &u)
+	flippedSign := IsEqual(t0, t1)
+	flippedSignI := IsEqual(t0, xi)
+
+	Mul(t1, z, &sqrtMinusOne)
+	Cmov(z, t1, flippedSign|flippedSignI)
+	Neg(t1, z)
+	Cmov(z, t1, IsNegative(z))
+	return correctSign | flippedSign
+}
+
+// IsEqual returns 1 if x = y mod p, or 0 otherwise, in constant time.
+func IsEqual(x, y *Elt) uint {
+	a, b := *x, *y
+	Modp(&a)
+	Modp(&b)
+	return uint(subtle.ConstantTimeCompare(a[:], b[:]))
+}
+
+// IsNegative returns 1 if x mod p is odd, or 0 otherwise, in constant time.
+func IsNegative(x *Elt) uint {
+	a := *x
+	Modp(&a)
+	return uint(a[0] & 1)
+}
+
+// sqrtCandidate calculates z = xy^3(xy^7)^((p-5)/8), which is a square root of
+// either x/y or -x/y if any of them is a quadratic-residue.
+func sqrtCandidate(z, x, y *Elt) {
 	t0, t1, t2, t3 := &Elt{}, &Elt{}, &Elt{}, &Elt{}
 
 	Mul(t0, x, y)   // t0 = u*v
@@ -111,19 +176,6 @@ func InvSqrt(z, x, y *Elt) (isQR bool) {
 	Mul(Tab[2], Tab[2], Tab[3])
 
 	Mul(z, t3, t2) // z = xy^(p+3)/8 = xy^3*(xy^7)^(p-5)/8
-	// Checking whether y z^2 == x
-	Sqr(t0, z)     // t0 = z^2
-	Mul(t0, t0, y) // t0 = yz^2
-	Sub(t1, t0, x) // t1 = t0-u
-	Add(t2, t0, x) // t2 = t0+u
-	if IsZero(t1) {
-		return true
-	} else if IsZero(t2) {
-		Mul(z, z, sqrtMinusOne) // z = z*sqrt(-1)
-		return true
-	} else {
-		return false
-	}
 }
 
 // Inv calculates z = 1/x mod p.
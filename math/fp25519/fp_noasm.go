@@ -1,4 +1,4 @@
-// +build !amd64 purego
+// +build !amd64 purego noasm
 
 package fp25519
 
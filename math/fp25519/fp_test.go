@@ -367,6 +367,71 @@ func TestInvSqrt(t *testing.T) {
 	}
 }
 
+func TestSqrtRatioM1(t *testing.T) {
+	const numTests = 1 << 9
+	var x, y, z Elt
+	prime := P()
+	p := conv.BytesLe2BigInt(prime[:])
+	sqrtMinusOne, _ := new(big.Int).SetString("2b8324804fc1df0b2b4d00993dfbd7a72f431806ad2fe478c4ee1b274a0ea0b0", 16)
+	var lhs, rhs big.Int
+	for i := 0; i < numTests; i++ {
+		_, _ = rand.Read(x[:])
+		_, _ = rand.Read(y[:])
+
+		gotQR := SqrtRatioM1(&z, &x, &y)
+		Modp(&z)
+		zz := conv.BytesLe2BigInt(z[:])
+		xx := conv.BytesLe2BigInt(x[:])
+		yy := conv.BytesLe2BigInt(y[:])
+
+		// Checks that y*z^2 = x if x/y is a quadratic residue, or
+		// y*z^2 = sqrt(-1)*x otherwise.
+		lhs.Mul(zz, zz).Mul(&lhs, yy).Mod(&lhs, p)
+		rhs.Mod(xx, p)
+		isQR := uint(0)
+		if big.Jacobi(new(big.Int).Mul(xx, yy), p) >= 0 {
+			isQR = 1
+		}
+		if isQR == 0 {
+			rhs.Mul(&rhs, sqrtMinusOne).Mod(&rhs, p)
+		}
+		if gotQR != isQR || lhs.Cmp(&rhs) != 0 || zz.Bit(0) != 0 {
+			test.ReportError(t, gotQR, isQR, x, y)
+		}
+	}
+
+	// Test cases from curve25519-dalek.
+	two := Elt{2}
+	four := Elt{4}
+	var sqrt2i, invSqrt4 Elt
+	conv.BigInt2BytesLe(sqrt2i[:], bigFromDecimal("38214883241950591754978413199355411911188925816896391856984770930832735035196"))
+	conv.BigInt2BytesLe(invSqrt4[:], bigFromDecimal("28948022309329048855892746252171976963317496166410141009864396001978282409974"))
+	for i, c := range []struct {
+		x, y, z Elt
+		isQR    uint
+	}{
+		{Elt{}, Elt{}, Elt{}, 1},
+		{Elt{1}, Elt{}, Elt{}, 0},
+		{two, Elt{1}, sqrt2i, 0},
+		{four, Elt{1}, two, 1},
+		{Elt{1}, four, invSqrt4, 1},
+	} {
+		gotQR := SqrtRatioM1(&z, &c.x, &c.y)
+		Modp(&z)
+		if gotQR != c.isQR || z != c.z {
+			test.ReportError(t, z, c.z, i, gotQR, c.isQR)
+		}
+	}
+}
+
+func bigFromDecimal(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("bad decimal")
+	}
+	return n
+}
+
 func TestGeneric(t *testing.T) {
 	t.Run("Cmov", func(t *testing.T) { testCmov(t, cmovGeneric) })
 	t.Run("Cswap", func(t *testing.T) { testCswap(t, cswapGeneric) })
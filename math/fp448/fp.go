@@ -1,7 +1,11 @@
 // Package fp448 provides prime field arithmetic over GF(2^448-2^224-1).
 package fp448
 
-import "github.com/cloudflare/circl/internal/conv"
+import (
+	"crypto/subtle"
+
+	"github.com/cloudflare/circl/internal/conv"
+)
 
 // Size in bytes of an element.
 const Size = 56
@@ -46,6 +50,122 @@ func Neg(z, x *Elt) { Sub(z, &p, x) }
 // Modp ensures that z is between [0,p-1].
 func Modp(z *Elt) { Sub(z, z, &p) }
 
+// InvSqrt calculates z = sqrt(x/y) iff x/y is a quadratic-residue, which is
+// indicated by returning isQR = true. Otherwise, when x/y is a quadratic
+// non-residue, z will have an undetermined value and isQR = false.
+func InvSqrt(z, x, y *Elt) (isQR bool) {
+	// Since p = 3 mod 4, then z = x^3*y*(x^5*y^3)^((p-3)/4) is a square root
+	// of x/y, if it exists.
+	t0, t1, t2 := &Elt{}, &Elt{}, &Elt{}
+	Mul(t0, x, y)   // t0 = x*y
+	Sqr(t1, x)      // t1 = x^2
+	Mul(t1, t1, t0) // t1 = x^3*y
+	Sqr(t2, t0)     // t2 = x^2*y^2
+	Mul(t2, t2, t1) // t2 = x^5*y^3
+	powPminus3div4(t2, t2)
+	Mul(z, t2, t1) // z = x^3*y*(x^5*y^3)^((p-3)/4)
+	// Checking whether y z^2 == x
+	Sqr(t0, z)     // t0 = z^2
+	Mul(t0, t0, y) // t0 = yz^2
+	Sub(t0, t0, x) // t0 = yz^2-x
+	return IsZero(t0)
+}
+
+// SqrtRatioM1 calculates z = sqrt(x/y) in constant time, following the
+// SQRT_RATIO_M1 function of RFC-9496 for decaf448. If x/y is a
+// quadratic-residue, z is its non-negative square root and isQR = 1.
+// Otherwise, z is the non-negative square root of -x/y and isQR = 0. If
+// y = 0, then z = 0, and isQR = 1 only if x = 0. The flag can be passed to
+// Cmov.
+func SqrtRatioM1(z, x, y *Elt) (isQR uint) {
+	t0, t1 := &Elt{}, &Elt{}
+	u, v := *x, *y
+	// Since p = 3 mod 4, then z = x*(x*y)^((p-3)/4) satisfies z^2 = x/y if
+	// x/y is a quadratic-residue, or z^2 = -x/y otherwise.
+	Mul(t0, &u, &v)
+	powPminus3div4(t0, t0)
+	Mul(z, t0, &u)
+	Sqr(t1, z)
+	Mul(t1, t1, &v) // t1 = yz^2
+	correctSign := IsEqual(t1, &u)
+
+	Neg(t1, z)
+	Cmov(z, t1, IsNegative(z))
+	return correctSign
+}
+
+// IsEqual returns 1 if x = y mod p, or 0 otherwise, in constant time.
+func IsEqual(x, y *Elt) uint {
+	a, b := *x, *y
+	Modp(&a)
+	Modp(&b)
+	return uint(subtle.ConstantTimeCompare(a[:], b[:]))
+}
+
+// IsNegative returns 1 if x mod p is odd, or 0 otherwise, in constant time.
+func IsNegative(x *Elt) uint {
+	a := *x
+	Modp(&a)
+	return uint(a[0] & 1)
+}
+
+// powPminus3div4 calculates z = x^((p-3)/4) = x^(2^446-2^222-1).
+func powPminus3div4(z, x *Elt) {
+	x3, x6, x24, x30, x222 := &Elt{}, &Elt{}, &Elt{}, &Elt{}, &Elt{}
+	t := &Elt{}
+	// xk denotes x^(2^k-1)
+	Sqr(x3, x)
+	Mul(x3, x3, x)
+	Sqr(x3, x3)
+	Mul(x3, x3, x)
+	Sqr(x6, x3)
+	for i := 0; i < 2; i++ {
+		Sqr(x6, x6)
+	}
+	Mul(x6, x6, x3)
+	Sqr(t, x6)
+	for i := 0; i < 5; i++ {
+		Sqr(t, t)
+	}
+	Mul(t, t, x6) // t = x12
+	Sqr(x24, t)
+	for i := 0; i < 11; i++ {
+		Sqr(x24, x24)
+	}
+	Mul(x24, x24, t)
+	Sqr(x30, x24)
+	for i := 0; i < 5; i++ {
+		Sqr(x30, x30)
+	}
+	Mul(x30, x30, x6)
+	Sqr(t, x24)
+	for i := 0; i < 23; i++ {
+		Sqr(t, t)
+	}
+	Mul(t, t, x24) // t = x48
+	Sqr(x222, t)
+	for i := 0; i < 47; i++ {
+		Sqr(x222, x222)
+	}
+	Mul(x222, x222, t) // x222 = x96
+	Sqr(t, x222)
+	for i := 0; i < 95; i++ {
+		Sqr(t, t)
+	}
+	Mul(t, t, x222) // t = x192
+	Sqr(x222, t)
+	for i := 0; i < 29; i++ {
+		Sqr(x222, x222)
+	}
+	Mul(x222, x222, x30)
+	Sqr(t, x222)
+	Mul(t, t, x) // t = x223
+	for i := 0; i < 223; i++ {
+		Sqr(t, t)
+	}
+	Mul(z, t, x222)
+}
+
 // Inv calculates z = 1/x mod p.
 func Inv(z, x *Elt) {
 	x0, x1, x2 := &Elt{}, &Elt{}, &Elt{}
@@ -1,4 +1,4 @@
-// +build amd64
+// +build amd64,!purego,!noasm
 
 #include "textflag.h"
 #include "fp_amd64.h"
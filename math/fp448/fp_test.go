@@ -295,6 +295,94 @@ func TestInv(t *testing.T) {
 	}
 }
 
+func TestInvSqrt(t *testing.T) {
+	const numTests = 1 << 9
+	var x, y, z Elt
+	prime := P()
+	p := conv.BytesLe2BigInt(prime[:])
+	exp := big.NewInt(1)
+	exp.Add(p, exp).Rsh(exp, 2)
+	var frac, root, sqRoot big.Int
+	for i := 0; i < numTests; i++ {
+		_, _ = rand.Read(x[:])
+		_, _ = rand.Read(y[:])
+
+		gotQR := InvSqrt(&z, &x, &y)
+		Modp(&z)
+		got := conv.BytesLe2BigInt(z[:])
+
+		xx := conv.BytesLe2BigInt(x[:])
+		yy := conv.BytesLe2BigInt(y[:])
+		frac.ModInverse(yy, p).Mul(&frac, xx).Mod(&frac, p)
+		root.Exp(&frac, exp, p)
+		sqRoot.Mul(&root, &root).Mod(&sqRoot, p)
+		wantQR := sqRoot.Cmp(&frac) == 0
+
+		if wantQR {
+			if gotQR != wantQR || got.Cmp(&root) != 0 {
+				test.ReportError(t, got, &root, x, y)
+			}
+		} else {
+			if gotQR != wantQR {
+				test.ReportError(t, gotQR, wantQR, x, y)
+			}
+		}
+	}
+}
+
+func TestSqrtRatioM1(t *testing.T) {
+	const numTests = 1 << 9
+	var x, y, z Elt
+	prime := P()
+	p := conv.BytesLe2BigInt(prime[:])
+	var lhs, rhs big.Int
+	for i := 0; i < numTests; i++ {
+		_, _ = rand.Read(x[:])
+		_, _ = rand.Read(y[:])
+
+		gotQR := SqrtRatioM1(&z, &x, &y)
+		Modp(&z)
+		zz := conv.BytesLe2BigInt(z[:])
+		xx := conv.BytesLe2BigInt(x[:])
+		yy := conv.BytesLe2BigInt(y[:])
+
+		// Checks that y*z^2 = x if x/y is a quadratic residue, or
+		// y*z^2 = -x otherwise.
+		lhs.Mul(zz, zz).Mul(&lhs, yy).Mod(&lhs, p)
+		rhs.Mod(xx, p)
+		isQR := uint(0)
+		if big.Jacobi(new(big.Int).Mul(xx, yy), p) >= 0 {
+			isQR = 1
+		}
+		if isQR == 0 {
+			rhs.Neg(&rhs).Mod(&rhs, p)
+		}
+		if gotQR != isQR || lhs.Cmp(&rhs) != 0 || zz.Bit(0) != 0 {
+			test.ReportError(t, gotQR, isQR, x, y)
+		}
+	}
+
+	four := Elt{4}
+	var half Elt
+	Inv(&half, &Elt{2})
+	Modp(&half)
+	for i, c := range []struct {
+		x, y, z Elt
+		isQR    uint
+	}{
+		{Elt{}, Elt{}, Elt{}, 1},
+		{Elt{1}, Elt{}, Elt{}, 0},
+		{four, Elt{1}, Elt{2}, 1},
+		{Elt{1}, four, half, 1},
+	} {
+		gotQR := SqrtRatioM1(&z, &c.x, &c.y)
+		Modp(&z)
+		if gotQR != c.isQR || z != c.z {
+			test.ReportError(t, z, c.z, i, gotQR, c.isQR)
+		}
+	}
+}
+
 func TestGeneric(t *testing.T) {
 	t.Run("Cmov", func(t *testing.T) { testCmov(t, cmovGeneric) })
 	t.Run("Cswap", func(t *testing.T) { testCswap(t, cswapGeneric) })
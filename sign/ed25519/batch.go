@@ -0,0 +1,126 @@
+package ed25519
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"io"
+
+	"github.com/cloudflare/circl/group/edwards25519"
+)
+
+// randomizerSize is the length in bytes of the random scalars used to
+// combine the verification equations of a batch.
+const randomizerSize = 16
+
+// BatchVerifier verifies many Ed25519 signatures at once. Entries are
+// added using Add, and all of them are verified at once by Verify.
+//
+// The signatures in a batch are checked with a random linear combination
+// of their verification equations, which is computed with a single
+// multi-scalar multiplication. Since the combined equation is multiplied by
+// the cofactor, a batch follows the VerifyCofactored rules, and can accept
+// signatures whose R or public key have small-order components that Verify
+// rejects.
+//
+// A BatchVerifier is not safe for concurrent use.
+type BatchVerifier struct {
+	entries []batchEntry
+}
+
+type batchEntry struct {
+	public [Size]byte
+	sig    [2 * Size]byte
+	hRAM   edwards25519.Scalar
+	// ok is false if the entry can be rejected without checking the
+	// verification equation.
+	ok bool
+}
+
+// NewBatchVerifier returns an empty BatchVerifier.
+func NewBatchVerifier() *BatchVerifier { return &BatchVerifier{} }
+
+// Len returns the number of entries in the batch.
+func (v *BatchVerifier) Len() int { return len(v.entries) }
+
+// Add appends the verification of signature for message under the public
+// key to the batch. The message is hashed on calling Add, so it is not
+// retained by the batch.
+func (v *BatchVerifier) Add(public PublicKey, message, signature []byte) {
+	var e batchEntry
+	e.ok = len(public) == Size && len(signature) == 2*Size
+	if e.ok {
+		copy(e.public[:], public)
+		copy(e.sig[:], signature)
+
+		var buf [sha512.Size]byte
+		H := sha512.New()
+		_, _ = H.Write(signature[:Size])
+		_, _ = H.Write(public)
+		_, _ = H.Write(message)
+		H.Sum(buf[:0])
+		e.hRAM.SetBytesWide(&buf)
+	}
+	v.entries = append(v.entries, e)
+}
+
+// Verify returns true if all the signatures in the batch are valid.
+// Otherwise, it returns false together with a slice reporting the validity
+// of each entry, in the same order they were added. On success, all the
+// values of the returned slice are true. An empty batch is valid.
+func (v *BatchVerifier) Verify() (bool, []bool) {
+	valid := make([]bool, len(v.entries))
+	if v.verifyBatch() {
+		for i := range valid {
+			valid[i] = true
+		}
+		return true, valid
+	}
+
+	// Verifies each signature individually to find the invalid ones.
+	allValid := true
+	for i := range v.entries {
+		e := &v.entries[i]
+		valid[i] = e.ok && checkEquation(e.public[:], e.sig[:], &e.hRAM, VerifyCofactored)
+		allValid = allValid && valid[i]
+	}
+	return allValid, valid
+}
+
+// verifyBatch checks that [8]([sum(z_i*s_i)]B - sum([z_i]R_i) -
+// sum([z_i*k_i]A_i)) is the identity point, where z_i are random scalars
+// and k_i = H(R_i,A_i,M_i).
+func (v *BatchVerifier) verifyBatch() bool {
+	n := len(v.entries)
+	points := make([]edwards25519.Point, 2*n)
+	scalars := make([]edwards25519.Scalar, 2*n)
+	var sumS edwards25519.Scalar
+	for i := range v.entries {
+		e := &v.entries[i]
+		var encA, encR, encS [Size]byte
+		copy(encA[:], e.public[:])
+		copy(encR[:], e.sig[:Size])
+		copy(encS[:], e.sig[Size:])
+
+		var S edwards25519.Scalar
+		R, A := &points[2*i], &points[2*i+1]
+		if !e.ok || !S.Unmarshal(&encS) || !R.Unmarshal(&encR) || !A.Unmarshal(&encA) {
+			return false
+		}
+		R.Neg(R)
+		A.Neg(A)
+
+		var enc [Size]byte
+		if _, err := io.ReadFull(rand.Reader, enc[:randomizerSize]); err != nil {
+			return false
+		}
+		z := &scalars[2*i]
+		_ = z.Unmarshal(&enc) // z < 2^128 is always less than the order.
+		scalars[2*i+1].Mul(z, &e.hRAM)
+		sumS.MulAdd(z, &S, &sumS)
+	}
+
+	var P edwards25519.Point
+	P.VarTimeMultiScalarBaseMult(&sumS, scalars, points)
+	P.MulByCofactor(&P)
+	return P.IsIdentity()
+}
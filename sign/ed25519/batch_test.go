@@ -0,0 +1,119 @@
+package ed25519_test
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/circl/internal/test"
+	eddsa "github.com/cloudflare/circl/sign/ed25519"
+)
+
+type batchInput struct {
+	public    eddsa.PublicKey
+	message   []byte
+	signature []byte
+}
+
+func genBatch(n int) []batchInput {
+	in := make([]batchInput, n)
+	for i := range in {
+		keys, _ := eddsa.GenerateKey(rand.Reader)
+		msg := make([]byte, 64)
+		_, _ = rand.Read(msg)
+		in[i] = batchInput{keys.GetPublic(), msg, eddsa.Sign(keys, msg)}
+	}
+	return in
+}
+
+func TestBatchVerifier(t *testing.T) {
+	const numSigs = 64
+
+	t.Run("empty", func(t *testing.T) {
+		got, valid := eddsa.NewBatchVerifier().Verify()
+		want := true
+		if got != want || len(valid) != 0 {
+			test.ReportError(t, got, want, valid)
+		}
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		v := eddsa.NewBatchVerifier()
+		for _, in := range genBatch(numSigs) {
+			v.Add(in.public, in.message, in.signature)
+		}
+		got, valid := v.Verify()
+		want := true
+		if got != want || v.Len() != numSigs || len(valid) != numSigs {
+			test.ReportError(t, got, want, v.Len(), len(valid))
+		}
+		for i := range valid {
+			if valid[i] != want {
+				test.ReportError(t, valid[i], want, i)
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		in := genBatch(numSigs)
+		bad := map[int]string{
+			3:  "wrong message",
+			7:  "wrong R",
+			11: "wrong S",
+			19: "S above order",
+			23: "wrong public key",
+			29: "short signature",
+			31: "invalid public key",
+		}
+		in[3].message[0] ^= 1
+		in[7].signature[0] ^= 1
+		in[11].signature[eddsa.Size] ^= 1
+		in[19].signature[2*eddsa.Size-1] = 0xFF
+		in[23].public = in[24].public
+		in[29].signature = in[29].signature[:eddsa.Size]
+		in[31].public = make(eddsa.PublicKey, eddsa.Size)
+		in[31].public[0] = 2
+
+		v := eddsa.NewBatchVerifier()
+		for i := range in {
+			v.Add(in[i].public, in[i].message, in[i].signature)
+		}
+		got, valid := v.Verify()
+		want := false
+		if got != want {
+			test.ReportError(t, got, want)
+		}
+		for i := range valid {
+			_, isBad := bad[i]
+			if valid[i] == isBad {
+				test.ReportError(t, valid[i], !isBad, i, bad[i])
+			}
+			got := eddsa.Verify(in[i].public, in[i].message, in[i].signature)
+			if got != valid[i] {
+				test.ReportError(t, got, valid[i], i)
+			}
+		}
+	})
+}
+
+func BenchmarkBatchVerifier(b *testing.B) {
+	for _, n := range []int{8, 64, 256} {
+		in := genBatch(n)
+		b.Run(fmt.Sprintf("single/%v", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for j := range in {
+					eddsa.Verify(in[j].public, in[j].message, in[j].signature)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("batch/%v", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				v := eddsa.NewBatchVerifier()
+				for j := range in {
+					v.Add(in[j].public, in[j].message, in[j].signature)
+				}
+				v.Verify()
+			}
+		})
+	}
+}
@@ -1,7 +1,21 @@
 // Package ed25519 implements Ed25519 signature scheme as described in RFC-8032.
 //
+// This package also implements the Ed25519ph and Ed25519ctx variants, which
+// can be selected through the Options type.
+//
+// Implementations of Ed25519 differ in the signatures they accept. Verify
+// applies fixed, documented rules, and VerifyWithOptions selects between
+// cofactorless (VerifyStrict), cofactored (VerifyCofactored), and ZIP-215
+// (VerifyZIP215) verification.
+//
+// The package builds on every architecture supported by Go. On amd64, the
+// field arithmetic uses assembly, unless the noasm or purego build tags are
+// set; elsewhere, it uses a pure-Go implementation.
+//
 // References:
 //  - RFC8032 https://rfc-editor.org/rfc/rfc8032.txt
 //  - Ed25519 https://ed25519.cr.yp.to/
 //  - High-speed high-security signatures. https://doi.org/10.1007/s13389-012-0027-1
+//  - ZIP-215 https://zips.z.cash/zip-0215
+//  - Taming the many EdDSAs. https://eprint.iacr.org/2020/1244
 package ed25519
@@ -7,6 +7,8 @@ import (
 	"crypto/sha512"
 	"errors"
 	"io"
+
+	"github.com/cloudflare/circl/group/edwards25519"
 )
 
 // Size is the length in bytes of Ed25519 keys.
@@ -30,16 +32,93 @@ func (k *KeyPair) GetPublic() PublicKey { return makeCopy(&k.public) }
 // Public returns a crypto.PublicKey corresponding to the private key.
 func (k *KeyPair) Public() crypto.PublicKey { return k.GetPublic() }
 
+// SchemeID is an identifier for each signature scheme.
+type SchemeID uint
+
+const (
+	// ED25519 is the pure Ed25519 signature scheme.
+	ED25519 SchemeID = iota
+	// ED25519Ph is the pre-hashed variant Ed25519ph, which signs the
+	// SHA-512 digest of a message.
+	ED25519Ph
+	// ED25519Ctx is the Ed25519ctx variant, which binds a non-empty
+	// context string to the signature.
+	ED25519Ctx
+)
+
+// ContextMaxSize is the maximum length in bytes of a context string.
+const ContextMaxSize = 255
+
+// VerifyMode selects the rules used to decide whether a signature is valid.
+// All modes require the scalar S of a signature to be less than the order
+// of the group, and differ in the encodings of points they accept and in
+// the verification equation they check.
+type VerifyMode uint
+
+const (
+	// VerifyStrict checks the cofactorless equation [S]B = R + [k]A, and
+	// requires the public key A and the point R to be canonically encoded.
+	// Points of small order are not rejected. This is the mode used by
+	// Verify, VerifyPh, and VerifyWithCtx.
+	VerifyStrict VerifyMode = iota
+	// VerifyCofactored checks the cofactored equation
+	// [8][S]B = [8]R + [8][k]A, and requires the public key A and the
+	// point R to be canonically encoded. It accepts the same signatures as
+	// BatchVerifier.
+	VerifyCofactored
+	// VerifyZIP215 follows the rules of ZIP-215: it checks the cofactored
+	// equation and accepts non-canonical encodings of A and R, as long as
+	// they decode to points on the curve. The encodings are hashed as
+	// they were received.
+	VerifyZIP215
+)
+
+// Options implements crypto.SignerOpts and selects the signature scheme
+// and the context string used by KeyPair.Sign and VerifyWithOptions.
+type Options struct {
+	// Scheme is the signature scheme to be used.
+	Scheme SchemeID
+	// Context is an optional context string, at most ContextMaxSize
+	// bytes long. It must be empty for ED25519 and non-empty for
+	// ED25519Ctx.
+	Context string
+	// Verify selects the verification rules used by VerifyWithOptions.
+	// It is ignored by KeyPair.Sign.
+	Verify VerifyMode
+}
+
+// HashFunc returns crypto.SHA512 for ED25519Ph, since messages are
+// expected to be pre-hashed with SHA-512, or zero otherwise.
+func (o *Options) HashFunc() crypto.Hash {
+	if o.Scheme == ED25519Ph {
+		return crypto.SHA512
+	}
+	return crypto.Hash(0)
+}
+
 // Sign signs the given message with priv.
-// Ed25519 performs two passes over messages to be signed and therefore cannot
-// handle pre-hashed messages. Thus opts.HashFunc() must return zero to
-// indicate the message hasn't been hashed. This can be achieved by passing
-// crypto.Hash(0) as the value for opts.
+// If opts.HashFunc() returns zero, the message is signed using pure Ed25519,
+// or Ed25519ctx if opts is an *Options with that scheme selected.
+// If opts.HashFunc() returns crypto.SHA512, the message must be the SHA-512
+// digest of the original message, and it is signed using Ed25519ph. This
+// allows signing large messages without buffering them in memory.
+// The rand argument is ignored, since signatures are deterministic.
 func (k *KeyPair) Sign(rand io.Reader, message []byte, opts crypto.SignerOpts) ([]byte, error) {
-	if opts.HashFunc() != crypto.Hash(0) {
-		return nil, errors.New("ed25519: cannot sign hashed message")
+	scheme, ctx, err := parseOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	switch scheme {
+	case ED25519:
+		return Sign(k, message), nil
+	case ED25519Ph:
+		if len(message) != sha512.Size {
+			return nil, errors.New("ed25519: bad message hash length")
+		}
+		return signAll(k, message, dom(1, ctx)), nil
+	default:
+		return signAll(k, message, dom(0, ctx)), nil
 	}
-	return Sign(k, message), nil
 }
 
 // GenerateKey generates a public/private key pair using entropy from rand.
@@ -61,13 +140,13 @@ func NewKeyFromSeed(private PrivateKey) *KeyPair {
 	if l := len(private); l != Size {
 		panic("ed25519: bad private key length")
 	}
-	var P pointR1
 	pk := new(KeyPair)
-	k := sha512.Sum512(private)
-	clamp(k[:])
-	reduceModOrder(k[:Size], false)
-	P.fixedMult(k[:Size])
-	P.ToBytes(pk.public[:])
+	h := sha512.Sum512(private)
+	var s edwards25519.Scalar
+	var P edwards25519.Point
+	secretScalar(&s, &h)
+	P.ScalarBaseMult(&s)
+	P.Marshal(&pk.public)
 	copy(pk.private[:], private[:Size])
 	return pk
 }
@@ -75,60 +154,225 @@ func NewKeyFromSeed(private PrivateKey) *KeyPair {
 // Sign returns the signature of a message using both the private and public
 // keys of the signer.
 func Sign(k *KeyPair, message []byte) []byte {
+	return signAll(k, message, nil)
+}
+
+// SignPh returns the Ed25519ph signature of a message using the context
+// string ctx, which can be empty. The message is hashed with SHA-512 before
+// signing; use KeyPair.Sign with an ED25519Ph option to sign a digest
+// computed in advance.
+func SignPh(k *KeyPair, message []byte, ctx string) ([]byte, error) {
+	if len(ctx) > ContextMaxSize {
+		return nil, errors.New("ed25519: bad context length")
+	}
+	ph := sha512.Sum512(message)
+	return signAll(k, ph[:], dom(1, ctx)), nil
+}
+
+// SignWithCtx returns the Ed25519ctx signature of a message using the
+// context string ctx, which must not be empty.
+func SignWithCtx(k *KeyPair, message []byte, ctx string) ([]byte, error) {
+	if len(ctx) == 0 || len(ctx) > ContextMaxSize {
+		return nil, errors.New("ed25519: bad context length")
+	}
+	return signAll(k, message, dom(0, ctx)), nil
+}
+
+func signAll(k *KeyPair, message, prefix []byte) []byte {
+	var a, r, hRAM, s edwards25519.Scalar
+	var buf [sha512.Size]byte
 	h := sha512.Sum512(k.private[:])
-	clamp(h[:])
+	secretScalar(&a, &h)
+
 	H := sha512.New()
+	_, _ = H.Write(prefix)
 	_, _ = H.Write(h[Size:])
 	_, _ = H.Write(message)
-	r := H.Sum(nil)
-	reduceModOrder(r[:], true)
+	H.Sum(buf[:0])
+	r.SetBytesWide(&buf)
 
-	var P pointR1
-	P.fixedMult(r[:Size])
-	signature := make([]byte, 2*Size)
-	P.ToBytes(signature[:Size])
+	var P edwards25519.Point
+	var R, S [Size]byte
+	P.ScalarBaseMult(&r)
+	P.Marshal(&R)
 
 	H.Reset()
-	_, _ = H.Write(signature[:Size])
+	_, _ = H.Write(prefix)
+	_, _ = H.Write(R[:])
 	_, _ = H.Write(k.public[:])
 	_, _ = H.Write(message)
-	hRAM := H.Sum(nil)
-	reduceModOrder(hRAM[:], true)
-	calculateS(signature[Size:], r[:Size], hRAM[:Size], h[:Size])
+	H.Sum(buf[:0])
+	hRAM.SetBytesWide(&buf)
+	s.MulAdd(&hRAM, &a, &r)
+	s.Marshal(&S)
+
+	signature := make([]byte, 2*Size)
+	copy(signature[:Size], R[:])
+	copy(signature[Size:], S[:])
 	return signature
 }
 
 // Verify returns true if the signature is valid. Failure cases are invalid
 // signature, or when the public key cannot be decoded.
+// Verify uses the VerifyStrict rules; use VerifyWithOptions to select other
+// rules.
 func Verify(public PublicKey, message, signature []byte) bool {
-	if len(public) != Size ||
-		len(signature) != 2*Size ||
-		!isLessThan(signature[Size:], order[:Size]) {
+	return verifyAll(public, message, signature, nil, VerifyStrict)
+}
+
+// VerifyPh returns true if the signature is a valid Ed25519ph signature of
+// message under the context string ctx, which can be empty.
+func VerifyPh(public PublicKey, message, signature []byte, ctx string) bool {
+	if len(ctx) > ContextMaxSize {
+		return false
+	}
+	ph := sha512.Sum512(message)
+	return verifyAll(public, ph[:], signature, dom(1, ctx), VerifyStrict)
+}
+
+// VerifyWithCtx returns true if the signature is a valid Ed25519ctx
+// signature of message under the context string ctx, which must not be
+// empty.
+func VerifyWithCtx(public PublicKey, message, signature []byte, ctx string) bool {
+	if len(ctx) == 0 || len(ctx) > ContextMaxSize {
+		return false
+	}
+	return verifyAll(public, message, signature, dom(0, ctx), VerifyStrict)
+}
+
+// VerifyWithOptions returns true if the signature is valid under the scheme
+// selected by opts, following the same conventions as KeyPair.Sign. In
+// particular, if opts.HashFunc() returns crypto.SHA512, the message must be
+// the SHA-512 digest of the original message. If opts is an *Options, its
+// Verify field selects the verification rules; otherwise, VerifyStrict is
+// used.
+func VerifyWithOptions(public PublicKey, message, signature []byte, opts crypto.SignerOpts) bool {
+	scheme, ctx, err := parseOptions(opts)
+	if err != nil {
 		return false
 	}
-	var P pointR1
-	if ok := P.FromBytes(public); !ok {
+	mode := VerifyStrict
+	if o, ok := opts.(*Options); ok {
+		mode = o.Verify
+	}
+	if mode > VerifyZIP215 {
 		return false
 	}
-	P.neg()
+	switch scheme {
+	case ED25519:
+		return verifyAll(public, message, signature, nil, mode)
+	case ED25519Ph:
+		if len(message) != sha512.Size {
+			return false
+		}
+		return verifyAll(public, message, signature, dom(1, ctx), mode)
+	default:
+		return verifyAll(public, message, signature, dom(0, ctx), mode)
+	}
+}
 
+func verifyAll(public PublicKey, message, signature, prefix []byte, mode VerifyMode) bool {
+	if len(public) != Size || len(signature) != 2*Size {
+		return false
+	}
+	var hRAM edwards25519.Scalar
+	var buf [sha512.Size]byte
 	H := sha512.New()
+	_, _ = H.Write(prefix)
 	_, _ = H.Write(signature[:Size])
 	_, _ = H.Write(public)
 	_, _ = H.Write(message)
-	hRAM := H.Sum(nil)
-	reduceModOrder(hRAM[:], true)
+	H.Sum(buf[:0])
+	hRAM.SetBytesWide(&buf)
+	return checkEquation(public, signature, &hRAM, mode)
+}
+
+// checkEquation returns true if the signature satisfies the verification
+// equation selected by mode, where hRAM is the reduced hash of R, A and the
+// message. The lengths of public and signature must be already validated.
+func checkEquation(public, signature []byte, hRAM *edwards25519.Scalar, mode VerifyMode) bool {
+	var S edwards25519.Scalar
+	var encA, encR, encS [Size]byte
+	copy(encA[:], public)
+	copy(encR[:], signature[:Size])
+	copy(encS[:], signature[Size:])
+	if !S.Unmarshal(&encS) {
+		return false
+	}
+
+	var A, R, Q edwards25519.Point
+	if !decodePoint(&A, &encA, mode) {
+		return false
+	}
+	A.Neg(&A)
+	Q.VarTimeDoubleScalarBaseMult(hRAM, &A, &S)
+	if mode == VerifyStrict {
+		// Since Q is encoded canonically, a non-canonical R is rejected.
+		var enc [Size]byte
+		Q.Marshal(&enc)
+		return bytes.Equal(enc[:], encR[:])
+	}
+
+	if !decodePoint(&R, &encR, mode) {
+		return false
+	}
+	Q.Sub(&Q, &R)
+	Q.MulByCofactor(&Q)
+	return Q.IsIdentity()
+}
+
+// decodePoint decodes a point following the encoding rules of mode.
+func decodePoint(P *edwards25519.Point, in *[Size]byte, mode VerifyMode) bool {
+	if mode == VerifyZIP215 {
+		return P.UnmarshalNonCanonical(in)
+	}
+	return P.Unmarshal(in)
+}
+
+// parseOptions obtains the scheme and the context string selected by opts.
+func parseOptions(opts crypto.SignerOpts) (SchemeID, string, error) {
+	var scheme SchemeID
+	var ctx string
+	if o, ok := opts.(*Options); ok {
+		scheme, ctx = o.Scheme, o.Context
+	} else {
+		switch opts.HashFunc() {
+		case crypto.Hash(0):
+			scheme = ED25519
+		case crypto.SHA512:
+			scheme = ED25519Ph
+		default:
+			return 0, "", errors.New("ed25519: expected crypto.SHA512 or unhashed message")
+		}
+	}
+	switch {
+	case scheme > ED25519Ctx:
+		return 0, "", errors.New("ed25519: unknown scheme")
+	case len(ctx) > ContextMaxSize,
+		scheme == ED25519 && len(ctx) != 0,
+		scheme == ED25519Ctx && len(ctx) == 0:
+		return 0, "", errors.New("ed25519: bad context length")
+	}
+	return scheme, ctx, nil
+}
 
-	var Q pointR1
-	Q.doubleMult(&P, signature[Size:], hRAM[:Size])
-	var enc [Size]byte
-	Q.ToBytes(enc[:])
-	return bytes.Equal(enc[:], signature[:Size])
+// dom returns the dom2(phflag, ctx) prefix defined in RFC-8032.
+func dom(phflag byte, ctx string) []byte {
+	const domPrefix = "SigEd25519 no Ed25519 collisions"
+	d := make([]byte, 0, len(domPrefix)+2+len(ctx))
+	d = append(d, domPrefix...)
+	d = append(d, phflag, byte(len(ctx)))
+	return append(d, ctx...)
 }
 
-func clamp(k []byte) {
+// secretScalar sets s to the secret scalar derived from h, the SHA-512 hash
+// of a private key, by clamping its first half as specified in RFC-8032.
+func secretScalar(s *edwards25519.Scalar, h *[sha512.Size]byte) {
+	var k [sha512.Size]byte
+	copy(k[:Size], h[:Size])
 	k[0] &= 248
 	k[Size-1] = (k[Size-1] & 127) | 64
+	s.SetBytesWide(&k)
 }
 
 func makeCopy(in *[Size]byte) []byte {
@@ -1,7 +1,10 @@
 package ed25519_test
 
 import (
+	"bytes"
+	"crypto"
 	"crypto/rand"
+	"crypto/sha512"
 	"fmt"
 	"testing"
 
@@ -52,6 +55,100 @@ func TestWrongPublicKey(t *testing.T) {
 	}
 }
 
+func TestSignerOptions(t *testing.T) {
+	keys, _ := eddsa.GenerateKey(rand.Reader)
+	msg := make([]byte, 1024)
+	_, _ = rand.Read(msg)
+
+	t.Run("prehashed", func(t *testing.T) {
+		// The digest is computed incrementally, as done for large inputs.
+		h := sha512.New()
+		_, _ = h.Write(msg[:512])
+		_, _ = h.Write(msg[512:])
+		digest := h.Sum(nil)
+
+		for _, opts := range []crypto.SignerOpts{
+			crypto.SHA512,
+			&eddsa.Options{Scheme: eddsa.ED25519Ph},
+			&eddsa.Options{Scheme: eddsa.ED25519Ph, Context: "foo"},
+		} {
+			ctx := ""
+			if o, ok := opts.(*eddsa.Options); ok {
+				ctx = o.Context
+			}
+			sig, err := keys.Sign(nil, digest, opts)
+			test.CheckNoErr(t, err, "sign failed")
+			want, _ := eddsa.SignPh(keys, msg, ctx)
+			if !bytes.Equal(sig, want) {
+				test.ReportError(t, sig, want, opts)
+			}
+			got := eddsa.VerifyWithOptions(keys.GetPublic(), digest, sig, opts) &&
+				eddsa.VerifyPh(keys.GetPublic(), msg, sig, ctx)
+			if got != true {
+				test.ReportError(t, got, true, opts)
+			}
+			got = eddsa.Verify(keys.GetPublic(), msg, sig) ||
+				eddsa.VerifyPh(keys.GetPublic(), msg, sig, ctx+"bar")
+			if got != false {
+				test.ReportError(t, got, false, opts)
+			}
+		}
+	})
+
+	t.Run("context", func(t *testing.T) {
+		opts := &eddsa.Options{Scheme: eddsa.ED25519Ctx, Context: "foo"}
+		sig, err := keys.Sign(nil, msg, opts)
+		test.CheckNoErr(t, err, "sign failed")
+		got := eddsa.VerifyWithOptions(keys.GetPublic(), msg, sig, opts) &&
+			eddsa.VerifyWithCtx(keys.GetPublic(), msg, sig, "foo")
+		if got != true {
+			test.ReportError(t, got, true, opts)
+		}
+		got = eddsa.Verify(keys.GetPublic(), msg, sig) ||
+			eddsa.VerifyWithCtx(keys.GetPublic(), msg, sig, "bar")
+		if got != false {
+			test.ReportError(t, got, false, opts)
+		}
+	})
+
+	t.Run("pure", func(t *testing.T) {
+		for _, opts := range []crypto.SignerOpts{
+			crypto.Hash(0),
+			&eddsa.Options{Scheme: eddsa.ED25519},
+		} {
+			sig, err := keys.Sign(nil, msg, opts)
+			test.CheckNoErr(t, err, "sign failed")
+			want := eddsa.Sign(keys, msg)
+			if !bytes.Equal(sig, want) {
+				test.ReportError(t, sig, want, opts)
+			}
+			got := eddsa.VerifyWithOptions(keys.GetPublic(), msg, sig, opts)
+			if got != true {
+				test.ReportError(t, got, true, opts)
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		longCtx := string(make([]byte, eddsa.ContextMaxSize+1))
+		for _, opts := range []crypto.SignerOpts{
+			crypto.SHA256,
+			&eddsa.Options{Scheme: eddsa.ED25519, Context: "foo"},
+			&eddsa.Options{Scheme: eddsa.ED25519Ctx},
+			&eddsa.Options{Scheme: eddsa.ED25519Ctx, Context: longCtx},
+			&eddsa.Options{Scheme: eddsa.ED25519Ph, Context: longCtx},
+			&eddsa.Options{Scheme: 10},
+		} {
+			_, err := keys.Sign(nil, msg[:sha512.Size], opts)
+			test.CheckIsErr(t, err, "sign must fail")
+		}
+		_, err := keys.Sign(nil, msg, crypto.SHA512)
+		test.CheckIsErr(t, err, "sign must fail on wrong digest length")
+		_, err = eddsa.SignWithCtx(keys, msg, "")
+		test.CheckIsErr(t, err, "sign must fail on empty context")
+	})
+}
+
 func BenchmarkEd25519(b *testing.B) {
 	msg := make([]byte, 256)
 	_, _ = rand.Read(msg)
@@ -280,7 +280,143 @@ var vectorsed25519 = [...]vector{
 	},
 }
 
+var vectorsed25519ctx = [...]vector{
+	{
+		name:   "-----TEST foo",
+		scheme: "Ed25519ctx",
+		sk: []byte{
+			0x03, 0x05, 0x33, 0x4e, 0x38, 0x1a, 0xf7, 0x8f, 0x14, 0x1c, 0xb6, 0x66, 0xf6, 0x19, 0x9f, 0x57,
+			0xbc, 0x34, 0x95, 0x33, 0x5a, 0x25, 0x6a, 0x95, 0xbd, 0x2a, 0x55, 0xbf, 0x54, 0x66, 0x63, 0xf6,
+		},
+		pk: []byte{
+			0xdf, 0xc9, 0x42, 0x5e, 0x4f, 0x96, 0x8f, 0x7f, 0x0c, 0x29, 0xf0, 0x25, 0x9c, 0xf5, 0xf9, 0xae,
+			0xd6, 0x85, 0x1c, 0x2b, 0xb4, 0xad, 0x8b, 0xfb, 0x86, 0x0c, 0xfe, 0xe0, 0xab, 0x24, 0x82, 0x92,
+		},
+		msg: []byte{
+			0xf7, 0x26, 0x93, 0x6d, 0x19, 0xc8, 0x00, 0x49, 0x4e, 0x3f, 0xda, 0xff, 0x20, 0xb2, 0x76, 0xa8,
+		},
+		msgLen: 16,
+		sig: []byte{
+			0x55, 0xa4, 0xcc, 0x2f, 0x70, 0xa5, 0x4e, 0x04, 0x28, 0x8c, 0x5f, 0x4c, 0xd1, 0xe4, 0x5a, 0x7b,
+			0xb5, 0x20, 0xb3, 0x62, 0x92, 0x91, 0x18, 0x76, 0xca, 0xda, 0x73, 0x23, 0x19, 0x8d, 0xd8, 0x7a,
+			0x8b, 0x36, 0x95, 0x0b, 0x95, 0x13, 0x00, 0x22, 0x90, 0x7a, 0x7f, 0xb7, 0xc4, 0xe9, 0xb2, 0xd5,
+			0xf6, 0xcc, 0xa6, 0x85, 0xa5, 0x87, 0xb4, 0xb2, 0x1f, 0x4b, 0x88, 0x8e, 0x4e, 0x7e, 0xdb, 0x0d,
+		},
+		ctx: []byte{
+			0x66, 0x6f, 0x6f,
+		},
+		ctxLen: 3,
+	},
+	{
+		name:   "-----TEST bar",
+		scheme: "Ed25519ctx",
+		sk: []byte{
+			0x03, 0x05, 0x33, 0x4e, 0x38, 0x1a, 0xf7, 0x8f, 0x14, 0x1c, 0xb6, 0x66, 0xf6, 0x19, 0x9f, 0x57,
+			0xbc, 0x34, 0x95, 0x33, 0x5a, 0x25, 0x6a, 0x95, 0xbd, 0x2a, 0x55, 0xbf, 0x54, 0x66, 0x63, 0xf6,
+		},
+		pk: []byte{
+			0xdf, 0xc9, 0x42, 0x5e, 0x4f, 0x96, 0x8f, 0x7f, 0x0c, 0x29, 0xf0, 0x25, 0x9c, 0xf5, 0xf9, 0xae,
+			0xd6, 0x85, 0x1c, 0x2b, 0xb4, 0xad, 0x8b, 0xfb, 0x86, 0x0c, 0xfe, 0xe0, 0xab, 0x24, 0x82, 0x92,
+		},
+		msg: []byte{
+			0xf7, 0x26, 0x93, 0x6d, 0x19, 0xc8, 0x00, 0x49, 0x4e, 0x3f, 0xda, 0xff, 0x20, 0xb2, 0x76, 0xa8,
+		},
+		msgLen: 16,
+		sig: []byte{
+			0xfc, 0x60, 0xd5, 0x87, 0x2f, 0xc4, 0x6b, 0x3a, 0xa6, 0x9f, 0x8b, 0x5b, 0x43, 0x51, 0xd5, 0x80,
+			0x8f, 0x92, 0xbc, 0xc0, 0x44, 0x60, 0x6d, 0xb0, 0x97, 0xab, 0xab, 0x6d, 0xbc, 0xb1, 0xae, 0xe3,
+			0x21, 0x6c, 0x48, 0xe8, 0xb3, 0xb6, 0x64, 0x31, 0xb5, 0xb1, 0x86, 0xd1, 0xd2, 0x8f, 0x8e, 0xe1,
+			0x5a, 0x5c, 0xa2, 0xdf, 0x66, 0x68, 0x34, 0x62, 0x91, 0xc2, 0x04, 0x3d, 0x4e, 0xb3, 0xe9, 0x0d,
+		},
+		ctx: []byte{
+			0x62, 0x61, 0x72,
+		},
+		ctxLen: 3,
+	},
+	{
+		name:   "-----TEST foo2",
+		scheme: "Ed25519ctx",
+		sk: []byte{
+			0x03, 0x05, 0x33, 0x4e, 0x38, 0x1a, 0xf7, 0x8f, 0x14, 0x1c, 0xb6, 0x66, 0xf6, 0x19, 0x9f, 0x57,
+			0xbc, 0x34, 0x95, 0x33, 0x5a, 0x25, 0x6a, 0x95, 0xbd, 0x2a, 0x55, 0xbf, 0x54, 0x66, 0x63, 0xf6,
+		},
+		pk: []byte{
+			0xdf, 0xc9, 0x42, 0x5e, 0x4f, 0x96, 0x8f, 0x7f, 0x0c, 0x29, 0xf0, 0x25, 0x9c, 0xf5, 0xf9, 0xae,
+			0xd6, 0x85, 0x1c, 0x2b, 0xb4, 0xad, 0x8b, 0xfb, 0x86, 0x0c, 0xfe, 0xe0, 0xab, 0x24, 0x82, 0x92,
+		},
+		msg: []byte{
+			0x50, 0x8e, 0x9e, 0x68, 0x82, 0xb9, 0x79, 0xfe, 0xa9, 0x00, 0xf6, 0x2a, 0xdc, 0xea, 0xca, 0x35,
+		},
+		msgLen: 16,
+		sig: []byte{
+			0x8b, 0x70, 0xc1, 0xcc, 0x83, 0x10, 0xe1, 0xde, 0x20, 0xac, 0x53, 0xce, 0x28, 0xae, 0x6e, 0x72,
+			0x07, 0xf3, 0x3c, 0x32, 0x95, 0xe0, 0x3b, 0xb5, 0xc0, 0x73, 0x2a, 0x1d, 0x20, 0xdc, 0x64, 0x90,
+			0x89, 0x22, 0xa8, 0xb0, 0x52, 0xcf, 0x99, 0xb7, 0xc4, 0xfe, 0x10, 0x7a, 0x5a, 0xbb, 0x5b, 0x2c,
+			0x40, 0x85, 0xae, 0x75, 0x89, 0x0d, 0x02, 0xdf, 0x26, 0x26, 0x9d, 0x89, 0x45, 0xf8, 0x4b, 0x0b,
+		},
+		ctx: []byte{
+			0x66, 0x6f, 0x6f,
+		},
+		ctxLen: 3,
+	},
+	{
+		name:   "-----TEST foo3",
+		scheme: "Ed25519ctx",
+		sk: []byte{
+			0xab, 0x9c, 0x28, 0x53, 0xce, 0x29, 0x7d, 0xda, 0xb8, 0x5c, 0x99, 0x3b, 0x3a, 0xe1, 0x4b, 0xca,
+			0xd3, 0x9b, 0x2c, 0x68, 0x2b, 0xea, 0xbc, 0x27, 0xd6, 0xd4, 0xeb, 0x20, 0x71, 0x1d, 0x65, 0x60,
+		},
+		pk: []byte{
+			0x0f, 0x1d, 0x12, 0x74, 0x94, 0x3b, 0x91, 0x41, 0x58, 0x89, 0x15, 0x2e, 0x89, 0x3d, 0x80, 0xe9,
+			0x32, 0x75, 0xa1, 0xfc, 0x0b, 0x65, 0xfd, 0x71, 0xb4, 0xb0, 0xdd, 0xa1, 0x0a, 0xd7, 0xd7, 0x72,
+		},
+		msg: []byte{
+			0xf7, 0x26, 0x93, 0x6d, 0x19, 0xc8, 0x00, 0x49, 0x4e, 0x3f, 0xda, 0xff, 0x20, 0xb2, 0x76, 0xa8,
+		},
+		msgLen: 16,
+		sig: []byte{
+			0x21, 0x65, 0x5b, 0x5f, 0x1a, 0xa9, 0x65, 0x99, 0x6b, 0x3f, 0x97, 0xb3, 0xc8, 0x49, 0xea, 0xfb,
+			0xa9, 0x22, 0xa0, 0xa6, 0x29, 0x92, 0xf7, 0x3b, 0x3d, 0x1b, 0x73, 0x10, 0x6a, 0x84, 0xad, 0x85,
+			0xe9, 0xb8, 0x6a, 0x7b, 0x60, 0x05, 0xea, 0x86, 0x83, 0x37, 0xff, 0x2d, 0x20, 0xa7, 0xf5, 0xfb,
+			0xd4, 0xcd, 0x10, 0xb0, 0xbe, 0x49, 0xa6, 0x8d, 0xa2, 0xb2, 0xe0, 0xdc, 0x0a, 0xd8, 0x96, 0x0f,
+		},
+		ctx: []byte{
+			0x66, 0x6f, 0x6f,
+		},
+		ctxLen: 3,
+	},
+}
+
+var vectorsed25519ph = [...]vector{
+	{
+		name:   "-----TEST abc",
+		scheme: "Ed25519ph",
+		sk: []byte{
+			0x83, 0x3f, 0xe6, 0x24, 0x09, 0x23, 0x7b, 0x9d, 0x62, 0xec, 0x77, 0x58, 0x75, 0x20, 0x91, 0x1e,
+			0x9a, 0x75, 0x9c, 0xec, 0x1d, 0x19, 0x75, 0x5b, 0x7d, 0xa9, 0x01, 0xb9, 0x6d, 0xca, 0x3d, 0x42,
+		},
+		pk: []byte{
+			0xec, 0x17, 0x2b, 0x93, 0xad, 0x5e, 0x56, 0x3b, 0xf4, 0x93, 0x2c, 0x70, 0xe1, 0x24, 0x50, 0x34,
+			0xc3, 0x54, 0x67, 0xef, 0x2e, 0xfd, 0x4d, 0x64, 0xeb, 0xf8, 0x19, 0x68, 0x34, 0x67, 0xe2, 0xbf,
+		},
+		msg: []byte{
+			0x61, 0x62, 0x63,
+		},
+		msgLen: 3,
+		sig: []byte{
+			0x98, 0xa7, 0x02, 0x22, 0xf0, 0xb8, 0x12, 0x1a, 0xa9, 0xd3, 0x0f, 0x81, 0x3d, 0x68, 0x3f, 0x80,
+			0x9e, 0x46, 0x2b, 0x46, 0x9c, 0x7f, 0xf8, 0x76, 0x39, 0x49, 0x9b, 0xb9, 0x4e, 0x6d, 0xae, 0x41,
+			0x31, 0xf8, 0x50, 0x42, 0x46, 0x3c, 0x2a, 0x35, 0x5a, 0x20, 0x03, 0xd0, 0x62, 0xad, 0xf5, 0xaa,
+			0xa1, 0x0b, 0x8c, 0x61, 0xe6, 0x36, 0x06, 0x2a, 0xaa, 0xd1, 0x1c, 0x2a, 0x26, 0x08, 0x34, 0x06,
+		},
+		ctx:    []byte{},
+		ctxLen: 0,
+	},
+}
+
 func (v vector) isPure() bool      { return v.scheme == "Ed25519Pure" }
+func (v vector) isCtx() bool       { return v.scheme == "Ed25519ctx" }
+func (v vector) isPh() bool        { return v.scheme == "Ed25519ph" }
 func (v vector) matchMsgLen() bool { return uint(len(v.msg)) == v.msgLen }
 func (v vector) matchCtxLen() bool { return uint(len(v.ctx)) == v.ctxLen }
 
@@ -324,3 +460,63 @@ func TestEd25519(t *testing.T) {
 		v.testVerify(t)
 	}
 }
+
+func (v vector) testSignCtx(t *testing.T) {
+	private := ed25519.NewKeyFromSeed(v.sk)
+	got, err := ed25519.SignWithCtx(private, v.msg, string(v.ctx))
+	want := v.sig
+	if err != nil || !bytes.Equal(got, want) {
+		test.ReportError(t, got, want, v.name, err)
+	}
+}
+
+func (v vector) testVerifyCtx(t *testing.T) {
+	got := ed25519.VerifyWithCtx(v.pk, v.msg, v.sig, string(v.ctx))
+	want := true
+	if got != want {
+		test.ReportError(t, got, want, v.name)
+	}
+}
+
+func (v vector) testSignPh(t *testing.T) {
+	private := ed25519.NewKeyFromSeed(v.sk)
+	got, err := ed25519.SignPh(private, v.msg, string(v.ctx))
+	want := v.sig
+	if err != nil || !bytes.Equal(got, want) {
+		test.ReportError(t, got, want, v.name, err)
+	}
+}
+
+func (v vector) testVerifyPh(t *testing.T) {
+	got := ed25519.VerifyPh(v.pk, v.msg, v.sig, string(v.ctx))
+	want := true
+	if got != want {
+		test.ReportError(t, got, want, v.name)
+	}
+}
+
+func TestEd25519Ctx(t *testing.T) {
+	for _, v := range vectorsed25519ctx {
+		got := v.isCtx() && v.matchMsgLen() && v.matchCtxLen()
+		want := true
+		if got != want {
+			test.ReportError(t, got, want, v.sk)
+		}
+		v.testPublicKey(t)
+		v.testSignCtx(t)
+		v.testVerifyCtx(t)
+	}
+}
+
+func TestEd25519Ph(t *testing.T) {
+	for _, v := range vectorsed25519ph {
+		got := v.isPh() && v.matchMsgLen() && v.matchCtxLen()
+		want := true
+		if got != want {
+			test.ReportError(t, got, want, v.sk)
+		}
+		v.testPublicKey(t)
+		v.testSignPh(t)
+		v.testVerifyPh(t)
+	}
+}
@@ -0,0 +1,172 @@
+package ed25519_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/circl/internal/test"
+	"github.com/cloudflare/circl/sign/ed25519"
+)
+
+func readJSON(t *testing.T, fileName string, v interface{}) {
+	jsonFile, err := os.Open(fileName)
+	if err != nil {
+		t.Fatalf("File %v can not be opened. Error: %v", fileName, err)
+	}
+	defer jsonFile.Close()
+	input, _ := ioutil.ReadAll(jsonFile)
+
+	err = json.Unmarshal(input, v)
+	if err != nil {
+		t.Fatalf("File %v can not be loaded. Error: %v", fileName, err)
+	}
+}
+
+func verifyMode(mode ed25519.VerifyMode, public, message, signature []byte) bool {
+	opts := &ed25519.Options{Verify: mode}
+	return ed25519.VerifyWithOptions(public, message, signature, opts)
+}
+
+// isCanonical returns true if k is the canonical encoding of a point,
+// assuming that the point is on the curve.
+func isCanonical(k []byte) bool {
+	p := new(big.Int).Lsh(big.NewInt(1), 255)
+	p.Sub(p, big.NewInt(19))
+	pMinusOne := new(big.Int).Sub(p, big.NewInt(1))
+
+	b := make([]byte, ed25519.Size)
+	for i := range k {
+		b[ed25519.Size-1-i] = k[i]
+	}
+	signX := b[0] >> 7
+	b[0] &= 0x7F
+	y := new(big.Int).SetBytes(b)
+	xIsZero := y.Cmp(big.NewInt(1)) == 0 || y.Cmp(pMinusOne) == 0
+	return y.Cmp(p) < 0 && !(xIsZero && signX == 1)
+}
+
+// TestSpeccheck validates the verification modes against the test vectors
+// of "Taming the many EdDSAs" by Chalkias, Garillot, and Nikolaenko.
+// Test vectors taken from https://github.com/novifinancial/ed25519-speccheck
+func TestSpeccheck(t *testing.T) {
+	var vectors []struct {
+		Message   string `json:"message"`
+		PublicKey string `json:"pub_key"`
+		Signature string `json:"signature"`
+	}
+	readJSON(t, "./testdata/speccheck_cases.json", &vectors)
+
+	const T, F = true, false
+	// Each row lists the expected result for VerifyStrict, VerifyCofactored
+	// and VerifyZIP215, respectively.
+	want := [][3]bool{
+		{T, T, T}, // 0: small order A, small order R
+		{T, T, T}, // 1: small order A, mixed order R
+		{T, T, T}, // 2: mixed order A, small order R
+		{T, T, T}, // 3: mixed order A, mixed order R
+		{F, T, T}, // 4: cofactored verify
+		{F, T, T}, // 5: cofactored verify computes 8(hA) instead of (8h mod L)A
+		{F, F, F}, // 6: non-canonical S (S > L)
+		{F, F, F}, // 7: non-canonical S (S >> L)
+		{F, F, F}, // 8: mixed order A, non-canonical small order R (accepted if R reduced before hashing)
+		{F, F, T}, // 9: mixed order A, non-canonical small order R (accepted if R not reduced before hashing)
+		{F, F, T}, // 10: non-canonical small order A, mixed order R (accepted if A reduced before hashing)
+		{F, F, T}, // 11: non-canonical small order A, mixed order R (accepted if A not reduced before hashing)
+	}
+	if len(vectors) != len(want) {
+		t.Fatalf("wrong number of test vectors: %v", len(vectors))
+	}
+
+	modes := []ed25519.VerifyMode{
+		ed25519.VerifyStrict,
+		ed25519.VerifyCofactored,
+		ed25519.VerifyZIP215,
+	}
+	for i, v := range vectors {
+		msg, _ := hex.DecodeString(v.Message)
+		pub, _ := hex.DecodeString(v.PublicKey)
+		sig, _ := hex.DecodeString(v.Signature)
+
+		for j, mode := range modes {
+			got := verifyMode(mode, pub, msg, sig)
+			if got != want[i][j] {
+				test.ReportError(t, got, want[i][j], i, mode)
+			}
+		}
+
+		got := ed25519.Verify(pub, msg, sig)
+		if got != want[i][0] {
+			test.ReportError(t, got, want[i][0], i)
+		}
+
+		b := ed25519.NewBatchVerifier()
+		b.Add(pub, msg, sig)
+		b.Add(pub, msg, sig)
+		got, _ = b.Verify()
+		if got != want[i][1] {
+			test.ReportError(t, got, want[i][1], i)
+		}
+	}
+}
+
+// TestZIP215 validates the verification modes against the test vectors of
+// ZIP-215, which must be accepted by VerifyZIP215. The other modes must
+// reject the vectors with non-canonical encodings.
+// Test vectors taken from https://zips.z.cash/zip-0215
+func TestZIP215(t *testing.T) {
+	var vectors [][2]string
+	readJSON(t, "./testdata/zip215.json", &vectors)
+	msg := []byte("Zcash")
+
+	for i, v := range vectors {
+		pub, _ := hex.DecodeString(v[0])
+		sig, _ := hex.DecodeString(v[1])
+		canonical := isCanonical(pub) && isCanonical(sig[:ed25519.Size])
+
+		got := verifyMode(ed25519.VerifyZIP215, pub, msg, sig)
+		want := true
+		if got != want {
+			test.ReportError(t, got, want, i)
+		}
+
+		got = verifyMode(ed25519.VerifyCofactored, pub, msg, sig)
+		want = canonical
+		if got != want {
+			test.ReportError(t, got, want, i)
+		}
+
+		if !canonical {
+			got = verifyMode(ed25519.VerifyStrict, pub, msg, sig)
+			want = false
+			if got != want {
+				test.ReportError(t, got, want, i)
+			}
+		}
+	}
+}
+
+func TestVerifyModes(t *testing.T) {
+	keys, _ := ed25519.GenerateKey(nil)
+	msg := []byte("A message to be signed")
+	sig := ed25519.Sign(keys, msg)
+	for _, mode := range []ed25519.VerifyMode{
+		ed25519.VerifyStrict,
+		ed25519.VerifyCofactored,
+		ed25519.VerifyZIP215,
+	} {
+		got := verifyMode(mode, keys.GetPublic(), msg, sig)
+		want := true
+		if got != want {
+			test.ReportError(t, got, want, mode)
+		}
+	}
+	got := verifyMode(ed25519.VerifyZIP215+1, keys.GetPublic(), msg, sig)
+	want := false
+	if got != want {
+		test.ReportError(t, got, want)
+	}
+}
@@ -0,0 +1,14 @@
+// Package ed448 implements Ed448 signature scheme as described in RFC-8032.
+//
+// This package also implements the Ed448ph variant, which can be selected
+// through the Options type. Both variants support context strings.
+//
+// The package builds on every architecture supported by Go. On amd64, the
+// field arithmetic uses assembly, unless the noasm or purego build tags are
+// set; elsewhere, it uses a pure-Go implementation.
+//
+// References:
+//  - RFC8032 https://rfc-editor.org/rfc/rfc8032.txt
+//  - Ed448-Goldilocks https://eprint.iacr.org/2015/625
+//  - Twisted Edwards curves revisited https://doi.org/10.1007/978-3-540-89255-7_20
+package ed448
@@ -0,0 +1,292 @@
+package ed448
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"errors"
+	"io"
+
+	"github.com/cloudflare/circl/group/edwards448"
+	"github.com/cloudflare/circl/internal/shake"
+)
+
+// Size is the length in bytes of Ed448 keys.
+const Size = 57
+
+// ContextMaxSize is the maximum length in bytes of a context string.
+const ContextMaxSize = 255
+
+// hashSize is the length in bytes of the pre-hashed message used by Ed448ph.
+const hashSize = 64
+
+// PublicKey represents a public key of Ed448.
+type PublicKey []byte
+
+// PrivateKey represents a private key of Ed448.
+type PrivateKey []byte
+
+// KeyPair implements crypto.Signer (golang.org/pkg/crypto/#Signer) interface.
+type KeyPair struct{ private, public [Size]byte }
+
+// GetPrivate returns a copy of the private key.
+func (k *KeyPair) GetPrivate() PrivateKey { return makeCopy(&k.private) }
+
+// GetPublic returns the public key corresponding to the private key.
+func (k *KeyPair) GetPublic() PublicKey { return makeCopy(&k.public) }
+
+// Public returns a crypto.PublicKey corresponding to the private key.
+func (k *KeyPair) Public() crypto.PublicKey { return k.GetPublic() }
+
+// SchemeID is an identifier for each signature scheme.
+type SchemeID uint
+
+const (
+	// ED448 is the pure Ed448 signature scheme.
+	ED448 SchemeID = iota
+	// ED448Ph is the pre-hashed variant Ed448ph, which signs the SHAKE256
+	// digest of a message.
+	ED448Ph
+)
+
+// Options implements crypto.SignerOpts and selects the signature scheme
+// and the context string used by KeyPair.Sign and VerifyWithOptions.
+type Options struct {
+	// Scheme is the signature scheme to be used.
+	Scheme SchemeID
+	// Context is an optional context string, at most ContextMaxSize
+	// bytes long.
+	Context string
+}
+
+// HashFunc returns zero, since there is no crypto.Hash identifier for
+// SHAKE256. Messages are hashed internally when Ed448ph is selected.
+func (o *Options) HashFunc() crypto.Hash { return crypto.Hash(0) }
+
+// Sign signs the given message with priv.
+// Ed448 performs two passes over messages to be signed and therefore cannot
+// handle pre-hashed messages. Thus opts.HashFunc() must return zero to
+// indicate the message hasn't been hashed. If opts is an *Options, it
+// selects either Ed448 or Ed448ph, and the context string; otherwise, the
+// message is signed using Ed448 with an empty context.
+// The rand argument is ignored, since signatures are deterministic.
+func (k *KeyPair) Sign(rand io.Reader, message []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts.HashFunc() != crypto.Hash(0) {
+		return nil, errors.New("ed448: cannot sign hashed message")
+	}
+	o, ok := opts.(*Options)
+	if !ok {
+		return Sign(k, message, "")
+	}
+	switch o.Scheme {
+	case ED448:
+		return Sign(k, message, o.Context)
+	case ED448Ph:
+		return SignPh(k, message, o.Context)
+	default:
+		return nil, errors.New("ed448: unknown scheme")
+	}
+}
+
+// GenerateKey generates a public/private key pair using entropy from rand.
+// If rand is nil, crypto/rand.Reader will be used.
+func GenerateKey(rnd io.Reader) (*KeyPair, error) {
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	private := make(PrivateKey, Size)
+	if _, err := io.ReadFull(rnd, private); err != nil {
+		return nil, err
+	}
+	return NewKeyFromSeed(private), nil
+}
+
+// NewKeyFromSeed generates a pair of Ed448 signing keys given a
+// previously-generated private key.
+func NewKeyFromSeed(private PrivateKey) *KeyPair {
+	if l := len(private); l != Size {
+		panic("ed448: bad private key length")
+	}
+	var P edwards448.Point
+	var s edwards448.Scalar
+	pk := new(KeyPair)
+	var h [2 * Size]byte
+	H := shake.NewShake256()
+	_, _ = H.Write(private)
+	_, _ = H.Read(h[:])
+	secretScalar(&s, &h)
+	P.ScalarBaseMult(&s)
+	P.Marshal(&pk.public)
+	copy(pk.private[:], private[:Size])
+	return pk
+}
+
+// Sign returns the Ed448 signature of a message under the context string
+// ctx, which can be empty, using both the private and public keys of the
+// signer.
+func Sign(k *KeyPair, message []byte, ctx string) ([]byte, error) {
+	if len(ctx) > ContextMaxSize {
+		return nil, errors.New("ed448: bad context length")
+	}
+	return signAll(k, message, dom(0, ctx)), nil
+}
+
+// SignPh returns the Ed448ph signature of a message under the context string
+// ctx, which can be empty. The message is hashed with SHAKE256 before
+// signing.
+func SignPh(k *KeyPair, message []byte, ctx string) ([]byte, error) {
+	if len(ctx) > ContextMaxSize {
+		return nil, errors.New("ed448: bad context length")
+	}
+	return signAll(k, prehash(message), dom(1, ctx)), nil
+}
+
+func signAll(k *KeyPair, message, prefix []byte) []byte {
+	var h, buf [2 * Size]byte
+	var a, r, hRAM, S edwards448.Scalar
+	H := shake.NewShake256()
+	_, _ = H.Write(k.private[:])
+	_, _ = H.Read(h[:])
+	secretScalar(&a, &h)
+
+	H.Reset()
+	_, _ = H.Write(prefix)
+	_, _ = H.Write(h[Size:])
+	_, _ = H.Write(message)
+	_, _ = H.Read(buf[:])
+	r.SetBytesWide(&buf)
+
+	var P edwards448.Point
+	var R [Size]byte
+	var encS [edwards448.ScalarSize]byte
+	P.ScalarBaseMult(&r)
+	P.Marshal(&R)
+
+	H.Reset()
+	_, _ = H.Write(prefix)
+	_, _ = H.Write(R[:])
+	_, _ = H.Write(k.public[:])
+	_, _ = H.Write(message)
+	_, _ = H.Read(buf[:])
+	hRAM.SetBytesWide(&buf)
+	S.MulAdd(&hRAM, &a, &r)
+	S.Marshal(&encS)
+
+	signature := make([]byte, 2*Size)
+	copy(signature[:Size], R[:])
+	copy(signature[Size:], encS[:])
+	return signature
+}
+
+// Verify returns true if the signature is a valid Ed448 signature of message
+// under the context string ctx, which can be empty. Failure cases are invalid
+// signature, or when the public key cannot be decoded.
+func Verify(public PublicKey, message, signature []byte, ctx string) bool {
+	if len(ctx) > ContextMaxSize {
+		return false
+	}
+	return verifyAll(public, message, signature, dom(0, ctx))
+}
+
+// VerifyPh returns true if the signature is a valid Ed448ph signature of
+// message under the context string ctx, which can be empty.
+func VerifyPh(public PublicKey, message, signature []byte, ctx string) bool {
+	if len(ctx) > ContextMaxSize {
+		return false
+	}
+	return verifyAll(public, prehash(message), signature, dom(1, ctx))
+}
+
+// VerifyWithOptions returns true if the signature is valid under the scheme
+// and context string selected by opts, following the same conventions as
+// KeyPair.Sign.
+func VerifyWithOptions(public PublicKey, message, signature []byte, opts crypto.SignerOpts) bool {
+	if opts.HashFunc() != crypto.Hash(0) {
+		return false
+	}
+	o, ok := opts.(*Options)
+	if !ok {
+		return Verify(public, message, signature, "")
+	}
+	switch o.Scheme {
+	case ED448:
+		return Verify(public, message, signature, o.Context)
+	case ED448Ph:
+		return VerifyPh(public, message, signature, o.Context)
+	default:
+		return false
+	}
+}
+
+func verifyAll(public PublicKey, message, signature, prefix []byte) bool {
+	if len(public) != Size || len(signature) != 2*Size {
+		return false
+	}
+	// The last byte of S must be zero, and the rest must encode an integer
+	// less than the order.
+	var S edwards448.Scalar
+	var encA [Size]byte
+	var encS [edwards448.ScalarSize]byte
+	copy(encA[:], public)
+	copy(encS[:], signature[Size:])
+	if signature[2*Size-1] != 0 || !S.Unmarshal(&encS) {
+		return false
+	}
+	var A edwards448.Point
+	if ok := A.Unmarshal(&encA); !ok {
+		return false
+	}
+	A.Neg(&A)
+
+	var buf [2 * Size]byte
+	var hRAM edwards448.Scalar
+	H := shake.NewShake256()
+	_, _ = H.Write(prefix)
+	_, _ = H.Write(signature[:Size])
+	_, _ = H.Write(public)
+	_, _ = H.Write(message)
+	_, _ = H.Read(buf[:])
+	hRAM.SetBytesWide(&buf)
+
+	var Q edwards448.Point
+	var enc [Size]byte
+	Q.VarTimeDoubleScalarBaseMult(&hRAM, &A, &S)
+	Q.Marshal(&enc)
+	return bytes.Equal(enc[:], signature[:Size])
+}
+
+// prehash returns the first 64 bytes of SHAKE256(message).
+func prehash(message []byte) []byte {
+	ph := make([]byte, hashSize)
+	H := shake.NewShake256()
+	_, _ = H.Write(message)
+	_, _ = H.Read(ph)
+	return ph
+}
+
+// dom returns the dom4(phflag, ctx) prefix defined in RFC-8032.
+func dom(phflag byte, ctx string) []byte {
+	const domPrefix = "SigEd448"
+	d := make([]byte, 0, len(domPrefix)+2+len(ctx))
+	d = append(d, domPrefix...)
+	d = append(d, phflag, byte(len(ctx)))
+	return append(d, ctx...)
+}
+
+// secretScalar sets s to the secret scalar derived from h, the SHAKE256
+// hash of a private key, by clamping its first half as specified in
+// RFC-8032.
+func secretScalar(s *edwards448.Scalar, h *[2 * Size]byte) {
+	var k [2 * Size]byte
+	copy(k[:Size], h[:Size])
+	k[0] &= 252
+	k[Size-2] |= 0x80
+	k[Size-1] = 0x00
+	s.SetBytesWide(&k)
+}
+
+func makeCopy(in *[Size]byte) []byte {
+	out := make([]byte, Size)
+	copy(out, in[:])
+	return out
+}
@@ -0,0 +1,178 @@
+package ed448_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/circl/internal/test"
+	"github.com/cloudflare/circl/sign/ed448"
+)
+
+func TestWrongPublicKey(t *testing.T) {
+	wrongPublicKeys := [...][ed448.Size]byte{
+		{ // y = p
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xfe, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0x00,
+		},
+		{ // y > p
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0x00,
+		},
+		{ // x^2 = u/v = (y^2-1)/(dy^2-1) is not a quadratic residue
+			0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00,
+		},
+		{ // y = 1 and x^2 = u/v = 0, and the sign of X is 1
+			0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00 | 0x80,
+		},
+		{ // unused bits of the last byte are not zero
+			0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x01,
+		},
+	}
+	sig := make([]byte, 2*ed448.Size)
+	for _, public := range wrongPublicKeys {
+		got := ed448.Verify(public[:], []byte(""), sig, "")
+		want := false
+		if got != want {
+			test.ReportError(t, got, want, public)
+		}
+	}
+}
+
+func TestSignerOptions(t *testing.T) {
+	keys, _ := ed448.GenerateKey(rand.Reader)
+	msg := make([]byte, 1024)
+	_, _ = rand.Read(msg)
+
+	for _, opts := range []*ed448.Options{
+		{Scheme: ed448.ED448},
+		{Scheme: ed448.ED448, Context: "foo"},
+		{Scheme: ed448.ED448Ph},
+		{Scheme: ed448.ED448Ph, Context: "foo"},
+	} {
+		sig, err := keys.Sign(nil, msg, opts)
+		test.CheckNoErr(t, err, "sign failed")
+
+		var want []byte
+		if opts.Scheme == ed448.ED448Ph {
+			want, _ = ed448.SignPh(keys, msg, opts.Context)
+		} else {
+			want, _ = ed448.Sign(keys, msg, opts.Context)
+		}
+		if !bytes.Equal(sig, want) {
+			test.ReportError(t, sig, want, opts)
+		}
+
+		got := ed448.VerifyWithOptions(keys.GetPublic(), msg, sig, opts)
+		if got != true {
+			test.ReportError(t, got, true, opts)
+		}
+		wrong := &ed448.Options{Scheme: opts.Scheme, Context: opts.Context + "bar"}
+		got = ed448.VerifyWithOptions(keys.GetPublic(), msg, sig, wrong)
+		if got != false {
+			test.ReportError(t, got, false, opts)
+		}
+	}
+
+	sig, err := keys.Sign(nil, msg, crypto.Hash(0))
+	test.CheckNoErr(t, err, "sign failed")
+	got := ed448.Verify(keys.GetPublic(), msg, sig, "")
+	if got != true {
+		test.ReportError(t, got, true)
+	}
+
+	longCtx := string(make([]byte, ed448.ContextMaxSize+1))
+	for _, opts := range []crypto.SignerOpts{
+		crypto.SHA512,
+		&ed448.Options{Scheme: ed448.ED448, Context: longCtx},
+		&ed448.Options{Scheme: ed448.ED448Ph, Context: longCtx},
+		&ed448.Options{Scheme: 10},
+	} {
+		_, err := keys.Sign(nil, msg, opts)
+		test.CheckIsErr(t, err, "sign must fail")
+	}
+}
+
+func BenchmarkEd448(b *testing.B) {
+	msg := make([]byte, 256)
+	_, _ = rand.Read(msg)
+
+	b.Run("keygen", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = ed448.GenerateKey(rand.Reader)
+		}
+	})
+	b.Run("sign", func(b *testing.B) {
+		keys, _ := ed448.GenerateKey(rand.Reader)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = ed448.Sign(keys, msg, "")
+		}
+	})
+	b.Run("verify", func(b *testing.B) {
+		keys, _ := ed448.GenerateKey(rand.Reader)
+		signature, _ := ed448.Sign(keys, msg, "")
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			ed448.Verify(keys.GetPublic(), msg, signature, "")
+		}
+	})
+}
+
+func Example_ed448() {
+	// import "github.com/cloudflare/circl/sign/ed448"
+
+	// Generating Alice's key pair
+	keys, err := ed448.GenerateKey(rand.Reader)
+	if err != nil {
+		panic("error on generating keys")
+	}
+
+	// Alice signs a message.
+	message := []byte("A message to be signed")
+	ctx := "My context string"
+	signature, err := ed448.Sign(keys, message, ctx)
+	if err != nil {
+		panic("error on signing message")
+	}
+
+	// Anyone can verify the signature using Alice's public key.
+	ok := ed448.Verify(keys.GetPublic(), message, signature, ctx)
+	fmt.Println(ok)
+	// Output: true
+}
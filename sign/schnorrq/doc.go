@@ -0,0 +1,13 @@
+// Package schnorrq implements the SchnorrQ signature scheme over the FourQ
+// elliptic curve.
+//
+// SchnorrQ uses SHA-512 as hash function, 32-byte keys and 64-byte
+// signatures. Interoperability with FourQlib has not been checked against
+// its test vectors yet. Signing runs in constant time; verification runs in variable time using
+// a double-scalar multiplication.
+//
+// References:
+//  - SchnorrQ https://www.microsoft.com/en-us/research/publication/schnorrq-schnorr-signatures-on-fourq/
+//  - FourQlib https://github.com/microsoft/FourQlib
+//  - FourQ https://eprint.iacr.org/2015/565
+package schnorrq
@@ -0,0 +1,110 @@
+package schnorrq
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// scalar is an integer modulo the order N of the generator of FourQ stored
+// as little-endian 64-bit words. Operations on scalars run in constant time.
+type scalar [4]uint64
+
+var (
+	// order is the order N of the generator point.
+	order = scalar{
+		0x2fb2540ec7768ce7, 0xdfbd004dfe0f7999,
+		0xf05397829cbc14e5, 0x0029cbc14e5e0a72,
+	}
+	// orderR2 is 2^512 mod N.
+	orderR2 = scalar{
+		0xc81db8795ff3d621, 0x173ea5aaea6b387d,
+		0x3d01b7c72136f61c, 0x0006a5f16ac8f9d3,
+	}
+	// orderPrime is -1/N mod 2^64.
+	orderPrime = uint64(0xe12fe5f079bc3929)
+)
+
+// fromBytes sets z = x mod N, where x is a 32-byte little-endian integer.
+func (z *scalar) fromBytes(x []byte) {
+	for i := range z {
+		z[i] = binary.LittleEndian.Uint64(x[8*i : 8*i+8])
+	}
+	montMul(z, z, &orderR2)
+	montMul(z, z, &scalar{1})
+}
+
+// toBytes encodes z as a 32-byte little-endian integer into out.
+func (z *scalar) toBytes(out []byte) {
+	for i := range z {
+		binary.LittleEndian.PutUint64(out[8*i:8*i+8], z[i])
+	}
+}
+
+// mulSub sets z = c - a*b mod N, for a, b, and c less than N.
+func (z *scalar) mulSub(a, b, c *scalar) {
+	var t scalar
+	montMul(&t, a, &orderR2)
+	montMul(&t, &t, b)
+	subMod(z, c, &t)
+}
+
+// subMod sets z = x - y mod N, for x and y less than N.
+func subMod(z, x, y *scalar) {
+	var b, c uint64
+	for i := range z {
+		z[i], b = bits.Sub64(x[i], y[i], b)
+	}
+	mask := -b
+	for i := range z {
+		z[i], c = bits.Add64(z[i], order[i]&mask, c)
+	}
+}
+
+// montMul sets z = x*y/2^256 mod N, for x*y less than N*2^256, using the
+// coarsely integrated operand scanning Montgomery multiplication.
+func montMul(z, x, y *scalar) {
+	var t [6]uint64
+	var c, cc, hi, lo uint64
+	for i := range x {
+		// t = t + x[i]*y
+		c = 0
+		for j := range y {
+			hi, lo = bits.Mul64(x[i], y[j])
+			lo, cc = bits.Add64(lo, t[j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			t[j], c = lo, hi
+		}
+		t[4], cc = bits.Add64(t[4], c, 0)
+		t[5] = cc
+
+		// t = (t + m*N)/2^64
+		m := t[0] * orderPrime
+		hi, lo = bits.Mul64(m, order[0])
+		_, cc = bits.Add64(lo, t[0], 0)
+		c = hi + cc
+		for j := 1; j < len(order); j++ {
+			hi, lo = bits.Mul64(m, order[j])
+			lo, cc = bits.Add64(lo, t[j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			t[j-1], c = lo, hi
+		}
+		t[3], cc = bits.Add64(t[4], c, 0)
+		t[4] = t[5] + cc
+	}
+
+	// z = t - N if t >= N, otherwise z = t
+	var r scalar
+	var b uint64
+	for i := range r {
+		r[i], b = bits.Sub64(t[i], order[i], b)
+	}
+	_, b = bits.Sub64(t[4], 0, b)
+	mask := -b
+	for i := range z {
+		z[i] = (t[i] & mask) | (r[i] &^ mask)
+	}
+}
@@ -0,0 +1,60 @@
+package schnorrq
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/cloudflare/circl/internal/conv"
+	"github.com/cloudflare/circl/internal/test"
+)
+
+func TestScalar(t *testing.T) {
+	const testTimes = 1 << 12
+	var buf [Size]byte
+	var a, b, c, z scalar
+	N := conv.Uint64Le2BigInt(order[:])
+	two256 := new(big.Int).Lsh(big.NewInt(1), 256)
+
+	t.Run("fromBytes", func(t *testing.T) {
+		edge := []*big.Int{
+			big.NewInt(0),
+			new(big.Int).Sub(N, big.NewInt(1)),
+			N,
+			new(big.Int).Sub(two256, big.NewInt(1)),
+		}
+		for i := 0; i < testTimes; i++ {
+			var x *big.Int
+			if i < len(edge) {
+				x = edge[i]
+			} else {
+				x, _ = rand.Int(rand.Reader, two256)
+			}
+			conv.BigInt2BytesLe(buf[:], x)
+			z.fromBytes(buf[:])
+			got := conv.Uint64Le2BigInt(z[:])
+			want := new(big.Int).Mod(x, N)
+			if got.Cmp(want) != 0 {
+				test.ReportError(t, got, want, x)
+			}
+		}
+	})
+
+	t.Run("mulSub", func(t *testing.T) {
+		want := new(big.Int)
+		for i := 0; i < testTimes; i++ {
+			x, _ := rand.Int(rand.Reader, N)
+			y, _ := rand.Int(rand.Reader, N)
+			w, _ := rand.Int(rand.Reader, N)
+			conv.BigInt2Uint64Le(a[:], x)
+			conv.BigInt2Uint64Le(b[:], y)
+			conv.BigInt2Uint64Le(c[:], w)
+			z.mulSub(&a, &b, &c)
+			got := conv.Uint64Le2BigInt(z[:])
+			want.Mul(x, y).Sub(w, want).Mod(want, N)
+			if got.Cmp(want) != 0 {
+				test.ReportError(t, got, want, x, y, w)
+			}
+		}
+	})
+}
@@ -0,0 +1,159 @@
+package schnorrq
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha512"
+	"errors"
+	"io"
+
+	"github.com/cloudflare/circl/ecc/fourq"
+)
+
+const (
+	// Size is the length in bytes of SchnorrQ keys.
+	Size = fourq.Size
+	// SignatureSize is the length in bytes of SchnorrQ signatures.
+	SignatureSize = 2 * Size
+)
+
+// PublicKey represents a public key of SchnorrQ.
+type PublicKey []byte
+
+// PrivateKey represents a private key of SchnorrQ.
+type PrivateKey []byte
+
+// KeyPair implements crypto.Signer (golang.org/pkg/crypto/#Signer) interface.
+type KeyPair struct{ private, public [Size]byte }
+
+// GetPrivate returns a copy of the private key.
+func (k *KeyPair) GetPrivate() PrivateKey { return makeCopy(&k.private) }
+
+// GetPublic returns the public key corresponding to the private key.
+func (k *KeyPair) GetPublic() PublicKey { return makeCopy(&k.public) }
+
+// Public returns a crypto.PublicKey corresponding to the private key.
+func (k *KeyPair) Public() crypto.PublicKey { return k.GetPublic() }
+
+// Sign signs the given message with priv. SchnorrQ hashes the message
+// itself, so opts.HashFunc() must return zero. The rand argument is ignored,
+// since signatures are deterministic.
+func (k *KeyPair) Sign(rand io.Reader, message []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts.HashFunc() != crypto.Hash(0) {
+		return nil, errors.New("schnorrq: cannot sign hashed message")
+	}
+	return Sign(k, message), nil
+}
+
+// GenerateKey generates a public/private key pair using entropy from rand.
+// If rand is nil, crypto/rand.Reader will be used.
+func GenerateKey(rnd io.Reader) (*KeyPair, error) {
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	private := make(PrivateKey, Size)
+	if _, err := io.ReadFull(rnd, private); err != nil {
+		return nil, err
+	}
+	return NewKeyFromSeed(private), nil
+}
+
+// NewKeyFromSeed generates a pair of SchnorrQ signing keys given a
+// previously-generated private key.
+func NewKeyFromSeed(private PrivateKey) *KeyPair {
+	if l := len(private); l != Size {
+		panic("schnorrq: bad private key length")
+	}
+	pk := new(KeyPair)
+	h := sha512.Sum512(private)
+	var k [Size]byte
+	var s scalar
+	var P fourq.Point
+	s.fromBytes(h[:Size])
+	s.toBytes(k[:])
+	P.ScalarBaseMult(&k)
+	P.Marshal(&pk.public)
+	copy(pk.private[:], private[:Size])
+	return pk
+}
+
+// Sign returns the signature of a message using both the private and public
+// keys of the signer.
+func Sign(k *KeyPair, message []byte) []byte {
+	var a, r, hRAM, s scalar
+	var buf [sha512.Size]byte
+	h := sha512.Sum512(k.private[:])
+	a.fromBytes(h[:Size])
+
+	H := sha512.New()
+	_, _ = H.Write(h[Size:])
+	_, _ = H.Write(message)
+	H.Sum(buf[:0])
+	r.fromBytes(buf[:Size])
+
+	var P fourq.Point
+	var R, encR [Size]byte
+	r.toBytes(encR[:])
+	P.ScalarBaseMult(&encR)
+	P.Marshal(&R)
+
+	H.Reset()
+	_, _ = H.Write(R[:])
+	_, _ = H.Write(k.public[:])
+	_, _ = H.Write(message)
+	H.Sum(buf[:0])
+	hRAM.fromBytes(buf[:Size])
+	s.mulSub(&hRAM, &a, &r)
+
+	signature := make([]byte, SignatureSize)
+	copy(signature[:Size], R[:])
+	s.toBytes(signature[Size:])
+	return signature
+}
+
+// Verify returns true if the signature is valid. Failure cases are invalid
+// signature, or when the public key cannot be decoded or is not a point of
+// order N.
+func Verify(public PublicKey, message, signature []byte) bool {
+	if len(public) != Size || len(signature) != SignatureSize {
+		return false
+	}
+	// The most significant bit of y0 in the encodings of A and R must be
+	// zero, and s must be less than 2^246.
+	if public[15]&0x80 != 0 || signature[15]&0x80 != 0 ||
+		signature[SignatureSize-1] != 0 || signature[SignatureSize-2]&0xC0 != 0 {
+		return false
+	}
+
+	var A, Q fourq.Point
+	var encA, encS [Size]byte
+	copy(encA[:], public)
+	copy(encS[:], signature[Size:])
+	if !A.Unmarshal(&encA) {
+		return false
+	}
+
+	var buf [sha512.Size]byte
+	var hRAM [Size]byte
+	H := sha512.New()
+	_, _ = H.Write(signature[:Size])
+	_, _ = H.Write(public)
+	_, _ = H.Write(message)
+	H.Sum(buf[:0])
+	copy(hRAM[:], buf[:Size])
+
+	// Q = [s]G + [h]A must be equal to R.
+	var enc [Size]byte
+	if !Q.VarTimeDoubleScalarBaseMult(&hRAM, &A, &encS) {
+		return false
+	}
+	Q.Marshal(&enc)
+	return bytes.Equal(enc[:], signature[:Size])
+}
+
+func makeCopy(in *[Size]byte) []byte {
+	out := make([]byte, Size)
+	copy(out, in[:])
+	return out
+}
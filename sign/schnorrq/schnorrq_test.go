@@ -0,0 +1,294 @@
+package schnorrq_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/circl/ecc/fourq"
+	"github.com/cloudflare/circl/internal/test"
+	"github.com/cloudflare/circl/sign/schnorrq"
+)
+
+type vector struct {
+	Secret string `json:"secret"`
+	Public string `json:"public"`
+	Msg    string `json:"msg"`
+	Sig    string `json:"sig"`
+}
+
+// TestVectors checks regression vectors that were generated by this package;
+// they are not taken from FourQlib.
+func TestVectors(t *testing.T) {
+	fileName := "testdata/vectors.json"
+	jsonFile, err := os.Open(fileName)
+	if err != nil {
+		t.Fatalf("File %v can not be opened. Error: %v", fileName, err)
+	}
+	defer jsonFile.Close()
+	input, _ := ioutil.ReadAll(jsonFile)
+
+	var vectors []vector
+	err = json.Unmarshal(input, &vectors)
+	if err != nil {
+		t.Fatalf("File %v can not be loaded. Error: %v", fileName, err)
+	}
+
+	for i, v := range vectors {
+		secret, _ := hex.DecodeString(v.Secret)
+		public, _ := hex.DecodeString(v.Public)
+		msg, _ := hex.DecodeString(v.Msg)
+		sig, _ := hex.DecodeString(v.Sig)
+
+		keys := schnorrq.NewKeyFromSeed(secret)
+		got := keys.GetPublic()
+		if !bytes.Equal(got, public) {
+			test.ReportError(t, got, public, i)
+		}
+		got = schnorrq.Sign(keys, msg)
+		if !bytes.Equal(got, sig) {
+			test.ReportError(t, got, sig, i)
+		}
+		if !schnorrq.Verify(public, msg, sig) {
+			test.ReportError(t, false, true, i)
+		}
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	const testTimes = 1 << 7
+	msg := make([]byte, 64)
+	for i := 0; i < testTimes; i++ {
+		keys, err := schnorrq.GenerateKey(rand.Reader)
+		test.CheckNoErr(t, err, "key generation failed")
+		_, _ = rand.Read(msg)
+		sig := schnorrq.Sign(keys, msg)
+		public := keys.GetPublic()
+
+		got := schnorrq.Verify(public, msg, sig)
+		want := true
+		if got != want {
+			test.ReportError(t, got, want, keys, msg)
+		}
+
+		msg[0] ^= 1
+		got = schnorrq.Verify(public, msg, sig)
+		want = false
+		if got != want {
+			test.ReportError(t, got, want, keys, msg)
+		}
+		msg[0] ^= 1
+
+		for _, j := range []int{0, schnorrq.Size} {
+			sig[j] ^= 1
+			got = schnorrq.Verify(public, msg, sig)
+			want = false
+			if got != want {
+				test.ReportError(t, got, want, keys, j)
+			}
+			sig[j] ^= 1
+		}
+	}
+}
+
+// toLE returns x mod N as a little-endian byte array.
+func toLE(x, N *big.Int) (out [schnorrq.Size]byte) {
+	b := new(big.Int).Mod(x, N).Bytes()
+	for i := range b {
+		out[i] = b[len(b)-1-i]
+	}
+	return
+}
+
+// fromLE returns the integer encoded by b in little-endian order.
+func fromLE(b []byte) *big.Int {
+	be := make([]byte, len(b))
+	for i := range b {
+		be[i] = b[len(b)-1-i]
+	}
+	return new(big.Int).SetBytes(be)
+}
+
+// forge returns a signature (R, s) of msg under public key A, computed with
+// the secret a as s = r - h*a, where h = H(R, A, msg). If A = [a]G + T for
+// some T of order 2, then [s]G + [h]A = R + [h]T, which equals R whenever h
+// is even. The returned flag tells whether h is even.
+func forge(A *fourq.Point, a *big.Int, msg []byte) (sig []byte, hEven bool) {
+	N := fourq.Params().N
+	var encA, encR, k [schnorrq.Size]byte
+	var R fourq.Point
+	_, _ = rand.Read(k[:])
+	r := fromLE(k[:])
+	k = toLE(r, N)
+	R.ScalarBaseMult(&k)
+	R.Marshal(&encR)
+	A.Marshal(&encA)
+
+	H := sha512.New()
+	_, _ = H.Write(encR[:])
+	_, _ = H.Write(encA[:])
+	_, _ = H.Write(msg)
+	h := fromLE(H.Sum(nil)[:schnorrq.Size])
+
+	s := new(big.Int).Mul(h, a)
+	s.Sub(r, s)
+	encS := toLE(s, N)
+	sig = append(encR[:], encS[:]...)
+	return sig, h.Bit(0) == 0
+}
+
+// TestTorsionPublicKey checks that Verify rejects public keys that are not
+// N-torsion points, even for signatures which would be accepted by checking
+// the equation [s]G + [h]A = R directly.
+func TestTorsionPublicKey(t *testing.T) {
+	const testTimes = 1 << 5
+	var encA, k [schnorrq.Size]byte
+	var A, T2, torsionA fourq.Point
+	// T2 = (0,-1) is the point of order 2.
+	T2.SetIdentity()
+	fourq.FqNeg(&T2.Y, &T2.Y)
+	msg := make([]byte, 64)
+
+	for i := 0; i < testTimes; i++ {
+		_, _ = rand.Read(k[:])
+		a := fromLE(k[:])
+		k = toLE(a, fourq.Params().N)
+		A.ScalarBaseMult(&k)
+		torsionA.Add(&A, &T2)
+
+		var sig []byte
+		hEven := false
+		for !hEven {
+			_, _ = rand.Read(msg)
+			sig, hEven = forge(&torsionA, a, msg)
+		}
+		torsionA.Marshal(&encA)
+		got := schnorrq.Verify(encA[:], msg, sig)
+		want := false
+		if got != want {
+			test.ReportError(t, got, want, encA, msg)
+		}
+
+		// The same construction with A yields valid signatures.
+		sig, _ = forge(&A, a, msg)
+		A.Marshal(&encA)
+		got = schnorrq.Verify(encA[:], msg, sig)
+		want = true
+		if got != want {
+			test.ReportError(t, got, want, encA, msg)
+		}
+	}
+}
+
+func TestWrongInputs(t *testing.T) {
+	keys, _ := schnorrq.GenerateKey(rand.Reader)
+	public := keys.GetPublic()
+	msg := []byte("message")
+	sig := schnorrq.Sign(keys, msg)
+
+	for _, tc := range []struct {
+		name string
+		key  bool // whether the public key or the signature is modified
+		pos  int
+		mask byte
+	}{
+		{"high bit of y0 in public key", true, 15, 0x80},
+		{"high bit of y0 in R", false, 15, 0x80},
+		{"s exceeds 246 bits", false, schnorrq.SignatureSize - 2, 0x40},
+		{"s exceeds 256 bits", false, schnorrq.SignatureSize - 1, 0x01},
+	} {
+		pk := append(schnorrq.PublicKey{}, public...)
+		sg := append([]byte{}, sig...)
+		if tc.key {
+			pk[tc.pos] |= tc.mask
+		} else {
+			sg[tc.pos] |= tc.mask
+		}
+		got := schnorrq.Verify(pk, msg, sg)
+		want := false
+		if got != want {
+			test.ReportError(t, got, want, tc.name)
+		}
+	}
+
+	got := schnorrq.Verify(public[:schnorrq.Size-1], msg, sig)
+	want := false
+	if got != want {
+		test.ReportError(t, got, want, "short public key")
+	}
+	got = schnorrq.Verify(public, msg, sig[:schnorrq.SignatureSize-1])
+	if got != want {
+		test.ReportError(t, got, want, "short signature")
+	}
+}
+
+func TestSigner(t *testing.T) {
+	keys, _ := schnorrq.GenerateKey(rand.Reader)
+	msg := []byte("message")
+
+	var signer crypto.Signer = keys
+	sig, err := signer.Sign(nil, msg, crypto.Hash(0))
+	test.CheckNoErr(t, err, "sign failed")
+	public, ok := signer.Public().(schnorrq.PublicKey)
+	if !ok || !schnorrq.Verify(public, msg, sig) {
+		test.ReportError(t, false, true, keys)
+	}
+
+	_, err = signer.Sign(nil, msg, crypto.SHA512)
+	if err == nil {
+		test.ReportError(t, err, "an error", crypto.SHA512)
+	}
+}
+
+func BenchmarkSchnorrQ(b *testing.B) {
+	msg := make([]byte, 256)
+	_, _ = rand.Read(msg)
+
+	b.Run("keygen", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = schnorrq.GenerateKey(rand.Reader)
+		}
+	})
+	b.Run("sign", func(b *testing.B) {
+		keys, _ := schnorrq.GenerateKey(rand.Reader)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			schnorrq.Sign(keys, msg)
+		}
+	})
+	b.Run("verify", func(b *testing.B) {
+		keys, _ := schnorrq.GenerateKey(rand.Reader)
+		signature := schnorrq.Sign(keys, msg)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			schnorrq.Verify(keys.GetPublic(), msg, signature)
+		}
+	})
+}
+
+func Example_schnorrq() {
+	// import "github.com/cloudflare/circl/sign/schnorrq"
+
+	// Generating Alice's key pair
+	keys, err := schnorrq.GenerateKey(rand.Reader)
+	if err != nil {
+		panic("error on generating keys")
+	}
+
+	// Alice signs a message.
+	message := []byte("A message to be signed")
+	signature := schnorrq.Sign(keys, message)
+
+	// Anyone can verify the signature using Alice's public key.
+	ok := schnorrq.Verify(keys.GetPublic(), message, signature)
+	fmt.Println(ok)
+	// Output: true
+}